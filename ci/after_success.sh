@@ -13,11 +13,11 @@ if [ "$RUN_TYPE" = "coverage" ]; then
       git config user.email ${GITHUB_USER_EMAIL}
       git config user.name ${GITHUB_USER_NAME}
 
-      cp $TRAVIS_BUILD_DIR/build/generated/golang/src/github.com/koinos/koinos-types-golang/* ./
-      cp $TRAVIS_BUILD_DIR/tests/golang/* ./
+      cp -r $TRAVIS_BUILD_DIR/build/generated/golang/src/github.com/koinos/koinos-types-golang/* ./
+      cp -r $TRAVIS_BUILD_DIR/tests/golang/* ./
 
       if ! git diff --exit-code; then
-         git add -u
+         git add -A
          git commit -m "Update for koinos-types commit $COMMIT_HASH"
          git push "https://${GITHUB_USER_TOKEN}@github.com/koinos/koinos-types-golang.git"
       fi
@@ -15,7 +15,7 @@ elif [ "$RUN_TYPE" = "coverage" ]; then
 
    popd
    go test ./tests/golang -coverprofile=./build/go-coverage.out -coverpkg=./build/generated/golang/src/github.com/koinos/koinos-types-golang
-   go test ./build/... -coverprofile=./build/go-generated-coverage.out -coverpkg=./build/generated/golang/src/github.com/koinos/koinos-types-golang
+   go test ./build/generated/golang/... -coverprofile=./build/go-generated-coverage.out -coverpkg=./build/generated/golang/src/github.com/koinos/koinos-types-golang
    gcov2lcov -infile=./build/go-coverage.out -outfile=./build/go-coverage.info
    gcov2lcov -infile=./build/go-generated-coverage.out -outfile=./build/go-generated-coverage.info
 
@@ -11,10 +11,11 @@ if [ "$RUN_TYPE" = "test" ]; then
 
    # Golang tests
    go test -v ./tests/golang
-   go test -v ./build/...
+   go test -v ./build/generated/golang/...
+   GOPATH=~/go:$(pwd)/build/generated/golang_namespaced go test -v github.com/koinos/koinos-types-golang/...
 
    # Compare multilingual outputs
-   python3 programs/canonical-output/check_canonical_output.py --lang-dir build/programs/canonical-output/lang --test-data programs/koinos-types/json/test_data.json
+   python3 programs/canonical-output/check_canonical_output.py --lang-dir build/programs/canonical-output/lang --test-data programs/koinos-types/json/test_data.json --golden tests/golang/testdata/golden_vectors.json --golden-dir build/programs/canonical-output/golden
 
    golint -set_exit_status ./...
 fi
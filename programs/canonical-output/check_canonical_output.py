@@ -113,12 +113,83 @@ def check_json(files, test_data, canon):
 
    return ok
 
+def check_decode(dirs, canon):
+   ok = True
+   print("Checking Decoding")
+
+   canon_bin = os.path.abspath(os.path.join(dirs[canon], 'types.bin'))
+   canon_json = os.path.abspath(os.path.join(dirs[canon], 'types.json'))
+
+   # Targets with a decode driver decode the canonical binary and compare it with the canonical json
+   for target, dir_name in dirs.items():
+      if target == canon or not os.path.isfile(os.path.join(dir_name, 'decode_driver.py')):
+         continue
+
+      p = subprocess.run(["./decode_driver.py", canon_bin, canon_json], cwd=dir_name)
+      if p.returncode != 0:
+         ok = False
+         print("Target %s does not decode canonical serialization" % target)
+
+   return ok
+
+def check_golden(dir_name, golden_file):
+   ok = True
+   print("Checking Golden Vectors")
+
+   with open(golden_file) as f:
+      vectors = json.load(f)["vectors"]
+
+   # The golden target serializes the JSON of every golden vector with the canonical implementation
+   subprocess.run(["./driver.py"], cwd=dir_name)
+
+   types_bin = os.path.join(dir_name, 'types.bin')
+   types_json = os.path.join(dir_name, 'types.json')
+
+   if not os.path.isfile(types_bin) or not os.path.isfile(types_json):
+      print("Golden target did not produce the output files: types.bin, types.json")
+      return False
+
+   with open(types_bin, "rb") as f:
+      for vector in vectors:
+         fbytes = f.read(4)
+         if len(fbytes) != 4:
+            print("Golden target unexpected EOF on vector " + vector["name"])
+            return False
+
+         data_size = struct.unpack('>i', fbytes)[0]
+         data = f.read(data_size).hex()
+         if data != vector["hex"]:
+            ok = False
+            print("Golden vector %s does not match canonical serialization." % vector["name"])
+            print("   golden: %s" % vector["hex"])
+            print("   canon: %s" % data)
+
+   with open(types_json) as f:
+      canon_json = json.load(f)
+
+   for vector, j in zip(vectors, canon_json):
+      try:
+         if ordered(vector["json"]) != ordered(j):
+            ok = False
+            print("Golden vector %s does not match canonical json." % vector["name"])
+            print("   golden: %s" % str(vector["json"]))
+            print("   canon: %s" % str(j))
+      except TypeError:
+         ok = False
+         print("Golden vector %s does not match canonical json." % vector["name"])
+         print("   golden: %s" % str(vector["json"]))
+         print("   canon: %s" % str(j))
+
+   return ok
+
 def main(argv):
    argparser = argparse.ArgumentParser(description="Check Canonical Output")
 
    argparser.add_argument("-l", "--lang-dir", metavar="DIR", default="", type=str, help="Directory containing canonical output language targets")
    argparser.add_argument("-t", "--test-data", metavar="FILE", default="", type=str, help="File containing json test data")
    argparser.add_argument("-c", "--canon", default="cpp", type=str, help="Target language to consider canon")
+   argparser.add_argument("-g", "--golden", metavar="FILE", default="", type=str, help="Golden vector corpus to compare with the canonical output")
+   argparser.add_argument("--golden-dir", metavar="DIR", default="", type=str, help="Directory containing the canonical output of the golden vectors")
    args = argparser.parse_args(argv)
 
    if args.lang_dir == "":
@@ -129,6 +200,7 @@ def main(argv):
 
    binary_files = {}
    json_files = {}
+   target_dirs = {}
 
    python_bin = shutil.which("python3")
 
@@ -154,6 +226,7 @@ def main(argv):
 
          binary_files[target] = open(types_bin, "rb")
          json_files[target] = open(types_json, "r")
+         target_dirs[target] = dir_name
          print("Success")
 
    if not args.canon in binary_files:
@@ -165,6 +238,12 @@ def main(argv):
 
    ok = check_binary(binary_files, test_data, args.canon)
    ok = check_json(json_files, test_data, args.canon) and ok
+   ok = check_decode(target_dirs, args.canon) and ok
+
+   if args.golden != "":
+      if args.golden_dir == "":
+         sys.exit("Required with golden: golden-dir")
+      ok = check_golden(args.golden_dir, args.golden) and ok
 
    return 0 if ok else 1
 
@@ -0,0 +1,10 @@
+#!/usr/bin/python3
+
+import shutil
+import subprocess
+import sys
+
+go_cmd = [shutil.which('go'), 'run', 'test.go', '-canon-binary', sys.argv[1], '-canon-json', sys.argv[2]]
+
+p = subprocess.Popen(go_cmd)
+sys.exit(p.wait())
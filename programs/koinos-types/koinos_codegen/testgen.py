@@ -57,6 +57,9 @@ def main(argv):
         generate_target = app.targets[args.target + "_test"]
         with open(args.json_data[0], "r") as f:
             json_data = json.load(f)
+        # A golden vector corpus lists its test cases under "vectors"
+        if isinstance(json_data, dict):
+            json_data = json_data["vectors"]
         generated, name = generate_target(json_data)
         target_filename = os.path.join(args.output, name)
         target_dir = os.path.dirname(target_filename)
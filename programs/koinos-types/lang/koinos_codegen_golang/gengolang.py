@@ -15,7 +15,23 @@ import re
 
 fixed_blobs = set()
 opaque = set()
-vectors = set()
+vectors = dict()
+vector_names = dict()
+
+# Import path of the generated module, as imported by the rt subpackages
+golang_module = "github.com/koinos/koinos-types-golang"
+
+# Package of the file being rendered, as a path relative to the module root ("" is the root package)
+current_package = ""
+
+# Package path of every declaration. Empty in the flat layout, where everything is in the root package.
+package_of = dict()
+
+# Packages referenced by each package
+package_imports = collections.defaultdict(set)
+
+# Packages referenced by the tests of each package
+test_package_imports = collections.defaultdict(set)
 
 class RenderError(Exception):
     pass
@@ -23,6 +39,14 @@ class RenderError(Exception):
 def fq_name(name):
     return "::".join(name)
 
+def idl_name(tref):
+    if tref["info"]["type"] == "IntLiteral":
+        return str(tref["value"])
+    name = "::".join(tref["name"])
+    if tref.get("targs") is not None:
+        name += "<" + ",".join(idl_name(targ) for targ in tref["targs"]) + ">"
+    return name
+
 def cpp_namespace(name):
     u = name.split("::")
     if len(u) <= 1:
@@ -61,33 +85,87 @@ def go_name(name):
 
     return u
 
+def namespace_package(namespace):
+    """Package path of an IDL namespace, koinos::rpc::block_store is rpc/blockstore"""
+    u = namespace.split("::")
+    if u[0] != "koinos":
+        return ""
+    return "/".join(name.replace("_", "") for name in u[1:])
+
+def package_name(package):
+    return package.split("/")[-1] if package != "" else "koinos"
+
+def package_alias(package):
+    return package.replace("/", "")
+
+def tref_package(tref):
+    """Package holding a type. Template instances live with their first argument, fixed blobs in the root package."""
+    name = fq_name(tref["name"])
+    if name == "koinos::fixed_blob":
+        return ""
+    if name in ("std::vector", "koinos::opaque"):
+        return tref_package(tref["targs"][0])
+    return package_of.get(name, "")
+
+def go_root_qualifier(package):
+    """Qualifier of the root package names, which the namespace packages import as koinos"""
+    return "koinos." if package != "" else ""
+
+def go_qualifier(tref):
+    """Package qualifier for a reference from the current package"""
+    if tref["info"]["type"] == "IntLiteral":
+        return ""
+    package = tref_package(tref)
+    if package == current_package:
+        return ""
+    if package == "":
+        return go_root_qualifier(current_package)
+    package_imports[current_package].add(package)
+    return package_alias(package) + "."
+
+def go_decl_qualifier(name):
+    """Package qualifier of a declaration for a reference from the current package"""
+    return go_qualifier({"name" : name.split("::"), "info" : {"type" : "Declaration"}})
+
+def go_is_foreign(tref):
+    if tref["info"]["type"] == "IntLiteral":
+        return False
+    return tref_package(tref) != current_package
+
 def decl_fixed_blob(length):
     fixed_blobs.add(length)
     return ""
 
 def get_fixed_blobs():
+    if current_package != "":
+        return []
     fb_list = list(fixed_blobs)
     fb_list.sort()
     return fb_list
 
-def decl_opaque(o_type, typename):
-    opaque.add((o_type, typename))
+def decl_opaque(o_type, typename, package=""):
+    opaque.add((o_type, typename, package))
     return ""
 
 def get_opaque():
-    o_list = list(opaque)
+    o_list = [(o_type, typename) for o_type, typename, package in opaque if package == current_package]
     o_list.sort()
     return o_list
 
-def decl_vector(v_type):
-    vectors.add(v_type)
+def decl_vector(v_type, name=None, package=""):
+    vectors[v_type] = package
+    if name is not None:
+        vector_names[v_type] = name
     return ""
 
 def get_vectors():
-    v_list = list(vectors)
+    v_list = [v_type for v_type, package in vectors.items() if package == current_package]
     v_list.sort()
     return v_list
 
+def get_vector_names():
+    return [(v_type, vector_names[v_type]) for v_type in get_vectors()]
+
 def is_struct(targ, decls_by_name):
     ns = "::"
     type_name = ns.join(targ["name"])
@@ -226,8 +304,329 @@ def get_good_bytes(targ, decls_by_name):
     else:
         return get_good_bytes(decls_by_name[type_name], decls_by_name)
 
+json_schema_draft = "https://json-schema.org/draft/2020-12/schema"
+# Any multibase encoding accepted by DecodeBytes
+json_schema_blob = {"type" : "string", "pattern" : "^([zZ][1-9A-HJ-NP-Za-km-z]*|0[01]*|7[0-7]*|9[0-9]*|[fF][0-9A-Fa-f]*|"
+                                                  "[bBcC][A-Za-z2-7=]*|[vVtT][0-9A-Va-v=]*|h[13-9A-KM-UW-Za-km-uw-z]*|"
+                                                  "[kK][0-9A-Za-z]*|[mMuU][A-Za-z0-9+/_=-]*)$"}
+json_schema_numeric_literal_max = (1 << 53) - 1
+
+def json_schema_int(bits, signed):
+    if signed:
+        return {"type" : "integer", "minimum" : -(1 << (bits - 1)), "maximum" : (1 << (bits - 1)) - 1}
+    return {"type" : "integer", "minimum" : 0, "maximum" : (1 << bits) - 1}
+
+def json_schema_big_int(signed):
+    number = {"type" : "integer",
+              "minimum" : -json_schema_numeric_literal_max if signed else 0,
+              "maximum" : json_schema_numeric_literal_max}
+    string = {"type" : "string", "pattern" : "^-?[0-9]+$" if signed else "^[0-9]+$"}
+    return {"anyOf" : [number, string]}
+
+json_schema_base_types = {
+    "std::string" : {"type" : "string"},
+    "koinos::boolean" : {"type" : "boolean"},
+    "koinos::int8" : json_schema_int(8, True),
+    "koinos::uint8" : json_schema_int(8, False),
+    "koinos::int16" : json_schema_int(16, True),
+    "koinos::uint16" : json_schema_int(16, False),
+    "koinos::int32" : json_schema_int(32, True),
+    "koinos::uint32" : json_schema_int(32, False),
+    "koinos::int64" : json_schema_int(64, True),
+    "koinos::uint64" : json_schema_int(64, False),
+    "koinos::int128" : json_schema_big_int(True),
+    "koinos::uint128" : json_schema_big_int(False),
+    "koinos::int160" : json_schema_big_int(True),
+    "koinos::uint160" : json_schema_big_int(False),
+    "koinos::int256" : json_schema_big_int(True),
+    "koinos::uint256" : json_schema_big_int(False),
+    "koinos::multihash" : json_schema_blob,
+    "koinos::variable_blob" : json_schema_blob,
+    "koinos::timestamp_type" : json_schema_int(64, False),
+    "koinos::block_height_type" : json_schema_int(64, False),
+}
 
-def generate_golang(schema):
+def json_schema_ref(tref):
+    name = fq_name(tref["name"])
+    targs = tref.get("targs") or []
+
+    if name == "std::vector":
+        return {"type" : "array", "items" : json_schema_ref(targs[0])}
+    elif name == "std::optional":
+        return {"anyOf" : [{"type" : "null"}, json_schema_ref(targs[0])]}
+    elif name == "std::variant":
+        return {"oneOf" : [{"type" : "object",
+                            "properties" : {"type" : {"const" : idl_name(targ)}, "value" : json_schema_ref(targ)},
+                            "required" : ["type", "value"],
+                            "additionalProperties" : False} for targ in targs]}
+    elif name == "koinos::fixed_blob":
+        return dict(json_schema_blob)
+    elif name == "koinos::opaque":
+        envelope = {"type" : "object",
+                    "properties" : {"type" : {"const" : idl_name(targs[0])}, "value" : json_schema_blob},
+                    "required" : ["type", "value"],
+                    "additionalProperties" : False}
+        return {"anyOf" : [json_schema_ref(targs[0]),
+                           {"type" : "object",
+                            "properties" : {"opaque" : envelope},
+                            "required" : ["opaque"],
+                            "additionalProperties" : False}]}
+    return {"$ref" : "#/$defs/" + name}
+
+def json_schema_decl(name, decl):
+    decl_type = decl["info"]["type"]
+    if decl_type == "Struct":
+        return {"type" : "object",
+                "properties" : collections.OrderedDict((f["name"], json_schema_ref(f["tref"])) for f in decl["fields"]),
+                "required" : [f["name"] for f in decl["fields"]],
+                "additionalProperties" : False}
+    elif decl_type == "Typedef":
+        return json_schema_ref(decl["tref"])
+    elif decl_type == "EnumClass":
+        # Numbers by default, IDL names with SetJSONEnumNames
+        return {"enum" : [e["value"] for e in decl["entries"]] + [e["name"] for e in decl["entries"]]}
+    return json_schema_base_types.get(name, {})
+
+def json_schema_instances(tref, instances):
+    if tref["info"]["type"] != "Typeref" or not tref.get("targs"):
+        return
+    instances[idl_name(tref)] = json_schema_ref(tref)
+    for targ in tref["targs"]:
+        json_schema_instances(targ, instances)
+
+def json_schema_bundle(decls_by_name):
+    import json
+    defs = collections.OrderedDict()
+    instances = dict()
+    for name, decl in decls_by_name.items():
+        defs[name] = json_schema_decl(name, decl)
+        if decl.get("tref") is not None:
+            json_schema_instances(decl["tref"], instances)
+        for f in decl.get("fields") or []:
+            json_schema_instances(f["tref"], instances)
+    for name in sorted(instances):
+        if name.startswith("std::variant<"):
+            continue
+        defs[name] = instances[name]
+    bundle = collections.OrderedDict([("$schema", json_schema_draft), ("$defs", defs)])
+    return json.dumps(json.dumps(bundle, separators=(",", ":")))
+
+
+# Types whose decoding never aliases its input
+plain_decode_types = ["Boolean", "Int8", "UInt8", "Int16", "UInt16", "Int32", "UInt32", "Int64", "UInt64",
+                      "Int128", "UInt128", "Int160", "UInt160", "Int256", "UInt256", "TimestampType", "BlockHeightType"]
+
+def decl_refs(tref, refs):
+    if tref["info"]["type"] == "IntLiteral":
+        return
+    refs.add(fq_name(tref["name"]))
+    for targ in tref.get("targs") or []:
+        decl_refs(targ, refs)
+
+def reaches(graph, src, dst):
+    seen = set()
+    pending = [src]
+    while pending:
+        package = pending.pop()
+        if package == dst:
+            return True
+        if package not in seen:
+            seen.add(package)
+            pending.extend(graph[package])
+    return False
+
+# Declarations the namespaced layout places outside the package of their namespace. The system call target
+# of koinos::chain is an operation argument in koinos::protocol, which koinos::chain refers back to.
+golang_package_overrides = collections.OrderedDict([
+    ("koinos::chain::system_call_target_reserved", "protocol"),
+    ("koinos::chain::thunk_id", "protocol"),
+    ("koinos::chain::contract_call_bundle", "protocol"),
+    ("koinos::chain::system_call_target", "protocol"),
+])
+
+def assign_packages(decls_by_name):
+    """Maps every declaration to the package of its namespace, or to its package in golang_package_overrides.
+
+    Go forbids import cycles, which IDL namespaces may have. A cycle between the packages is a RenderError
+    naming the declarations behind it, which golang_package_overrides must place in one package.
+    """
+    refs = dict()
+    for name, decl in decls_by_name.items():
+        r = set()
+        if decl.get("tref") is not None:
+            decl_refs(decl["tref"], r)
+        for field in decl.get("fields") or []:
+            decl_refs(field["tref"], r)
+        refs[name] = set(ref for ref in r if ref in decls_by_name and ref != name)
+
+    packages = collections.OrderedDict((name, golang_package_overrides.get(name, namespace_package(cpp_namespace(name))))
+                                       for name in decls_by_name)
+    edges = collections.defaultdict(set)
+    for name, targets in refs.items():
+        for target in targets:
+            if packages[target] != packages[name]:
+                edges[(packages[name], packages[target])].add(target)
+
+    # Every package imports the root package
+    graph = collections.defaultdict(set)
+    for src, dst in edges:
+        graph[src].add(dst)
+    for package in set(packages.values()):
+        if package != "":
+            graph[package].add("")
+
+    for src, dst in sorted(edges):
+        if dst != "" and reaches(graph, dst, src):
+            uses = ["%s uses %s" % (package_name(a), ", ".join(sorted(edges[(a, b)])))
+                    for a, b in [(src, dst), (dst, src)] if (a, b) in edges]
+            raise RenderError("Import cycle between packages %s and %s, %s. golang_package_overrides can place them in one package." %
+                              (package_name(src), package_name(dst), "; ".join(uses)))
+    return packages
+
+# Standard packages imported by the declarations of every package, with a name each package references to
+# keep the imports its declarations do not use
+golang_std_imports = collections.OrderedDict([
+    ("fmt", "fmt.Errorf"),
+    ("errors", "errors.New"),
+    ("encoding/json", "json.Marshal"),
+    ("math/rand", "rand.Int"),
+    ("reflect", "reflect.TypeOf"),
+    ("strings", "strings.Contains"),
+    ("sync", "sync.NewCond"),
+    ("sync/atomic", "atomic.LoadPointer"),
+    ("unsafe", "unsafe.Pointer(nil)"),
+])
+
+golang_impl_import = '"%s/internal/impl"' % golang_module
+golang_pretty_import = '"%s/pretty"' % golang_module
+
+def go_import_line(package):
+    alias = package_alias(package)
+    if alias != package_name(package):
+        return '%s "%s/%s"' % (alias, golang_module, package)
+    return '"%s/%s"' % (golang_module, package)
+
+def go_package_import_lines(package):
+    """Generated packages imported by a package, the root package and the packages it references"""
+    lines = []
+    if package != "":
+        lines.append('"%s"' % golang_module)
+    lines.extend(go_import_line(imported) for imported in sorted(package_imports[package]))
+    return lines
+
+def go_import_lines(package):
+    """Imports of the declarations of a package, the standard and runtime packages, then the generated packages"""
+    lines = ['"%s"' % path for path in golang_std_imports] + [""]
+    if package != "":
+        lines.append('"%s"' % golang_module)
+    lines.extend([golang_impl_import, golang_pretty_import])
+    return lines + [go_import_line(imported) for imported in sorted(package_imports[package])]
+
+def package_test_alias(package):
+    return package_alias(package) if package != "" else "koinos"
+
+def go_test_qualifier(tref):
+    """Package qualifier for a reference from the tests of the current package, which are in a separate package"""
+    if tref["info"]["type"] == "IntLiteral":
+        return ""
+    package = tref_package(tref)
+    test_package_imports[current_package].add(package)
+    return package_test_alias(package) + "."
+
+def go_test_package():
+    """Qualifier of the current package in its tests"""
+    test_package_imports[current_package].add(current_package)
+    return package_test_alias(current_package) + "."
+
+def go_test_import_lines(package):
+    """Generated packages imported by the tests of a package, the root package and the packages they reference"""
+    lines = ['"%s"' % golang_module]
+    lines.extend(go_import_line(imported) for imported in sorted(test_package_imports[package] - {""}))
+    return lines
+
+def package_context(package, package_decls, go_imports):
+    return {"go_package" : package,
+            "go_package_name" : package_name(package),
+            "go_root_path" : "../" * (package.count("/") + 1) if package != "" else "",
+            "root" : go_root_qualifier(package),
+            "package_decls" : package_decls,
+            "go_imports" : go_imports,
+            "go_import_references" : list(golang_std_imports.values()) + ["impl.Uvarint", "pretty.NewWriter"]}
+
+def render_tests(env, ctx, package, package_ctx, result_files):
+    """Renders the tests of a package twice, the first pass collects the packages they import"""
+    for template_name in ["koinos_test.go.j2", "koinos_fuzz_test.go.j2"]:
+        j2_template = env.get_template(template_name)
+        test_package_imports[package].clear()
+        j2_template.render(ctx, go_test_imports=[], **package_ctx)
+        out_filename = package_file(package, os.path.splitext(template_name)[0])
+        result_files[out_filename] = j2_template.render(ctx, go_test_imports=go_test_import_lines(package), **package_ctx)
+
+# Helpers of declarations, rendered into each package holding one of their groups of declarations. The
+# declarations of a group must share a package.
+golang_decl_templates = [
+    ("blockview.go.j2", [["koinos::protocol::block", "koinos::protocol::block_header", "koinos::protocol::transaction",
+                          "koinos::protocol::active_block_data", "koinos::protocol::passive_block_data"]]),
+    ("forkheads.go.j2", [["koinos::broadcast::fork_heads"]]),
+    ("systemcallid.go.j2", [["koinos::chain::system_call_id"], ["koinos::chain::thunk_id"]]),
+]
+
+def decl_template_packages(template_name, groups, decls_by_name):
+    """Packages a helper template is rendered into, skipping groups the schema does not declare"""
+    result = set()
+    for names in groups:
+        if any(name not in decls_by_name for name in names):
+            continue
+        packages = set(package_of.get(name, "") for name in names)
+        if len(packages) > 1:
+            raise RenderError("%s uses declarations of several packages: %s" %
+                              (template_name, ", ".join(package_name(package) for package in sorted(packages))))
+        result |= packages
+    return result
+
+# Runtime packages using declarations of the schema, rendered into the same package in both layouts, along with
+# the declarations they use
+golang_package_templates = [
+    ("broadcast", "broadcast.go.j2", ["koinos::broadcast::transaction_accepted", "koinos::broadcast::block_accepted",
+                                      "koinos::broadcast::block_irreversible", "koinos::broadcast::fork_heads"]),
+]
+
+def render_package_template(env, ctx, package, template_name, result_files):
+    """Renders a runtime package template twice, the first pass collects the packages it imports"""
+    global current_package
+    current_package = package
+    declarations_imports = package_imports.pop(package, set())
+    j2_template = env.get_template(template_name)
+    j2_template.render(ctx, **package_context(package, {}, []))
+    package_ctx = package_context(package, {}, go_package_import_lines(package))
+    result_files[package_file(package, os.path.splitext(template_name)[0])] = j2_template.render(ctx, **package_ctx)
+    package_imports[package] = declarations_imports
+    current_package = ""
+
+def block_view_fields(decls_by_name):
+    """Fields of koinos::protocol::block with BlockView accessors. The view indexes the transactions, which must be
+    the last field, and reads transaction IDs, which must be the first field of a transaction."""
+    fields = decls_by_name["koinos::protocol::block"]["fields"]
+    if len(fields) == 0 or fields[-1]["name"] != "transactions" or \
+            idl_name(fields[-1]["tref"]) != "std::vector<koinos::protocol::transaction>":
+        raise RenderError("BlockView requires transactions, a std::vector<koinos::protocol::transaction>, "
+                          "as the last field of koinos::protocol::block")
+    transaction_fields = decls_by_name["koinos::protocol::transaction"]["fields"]
+    if len(transaction_fields) == 0 or transaction_fields[0]["name"] != "id" or \
+            idl_name(transaction_fields[0]["tref"]) != "koinos::multihash":
+        raise RenderError("BlockView requires id, a koinos::multihash, as the first field of koinos::protocol::transaction")
+    return fields[:-1]
+
+def package_file(package, filename):
+    return package + "/" + filename if package != "" else filename
+
+test_data_path = os.path.join(os.path.dirname(__file__), "..", "..", "json", "test_data.json")
+
+def generate_golang(schema, namespaced=False):
+    """Renders the Go package. The flat layout puts every namespace in package koinos, the namespaced layout
+    puts each koinos::x::y namespace in a package x/y below the root package, which holds koinos:: and the runtime."""
+    global current_package, package_of
+    import json
     env = jinja2.Environment(
             loader=jinja2.PackageLoader(__package__, "templates"),
             keep_trailing_newline=True,
@@ -237,16 +636,38 @@ def generate_golang(schema):
     decls_by_name = collections.OrderedDict(((fq_name(name), decl) for name, decl in schema["decls"]))
     decl_namespaces = sorted(set(cpp_namespace(name) for name in decls_by_name))
 
+    fixed_blobs.clear()
+    opaque.clear()
+    vectors.clear()
+    vector_names.clear()
+    package_imports.clear()
+    test_package_imports.clear()
+    current_package = ""
+    package_of = assign_packages(decls_by_name) if namespaced else dict()
+
     ctx = {"schema" : schema,
+           "schema_json" : json.dumps(json.dumps(schema, separators=(",", ":"))),
+           "json_schema_json" : json_schema_bundle(decls_by_name),
            "decls_by_name" : decls_by_name,
+           "plain_decode_types" : plain_decode_types,
            "decl_namespaces" : decl_namespaces,
+           "go_module" : golang_module,
            "go_name" : go_name,
+           "idl_name" : idl_name,
+           "tref_package" : tref_package,
+           "qualifier" : go_qualifier,
+           "decl_qualifier" : go_decl_qualifier,
+           "block_view_fields" : block_view_fields,
+           "test_qualifier" : go_test_qualifier,
+           "test_package" : go_test_package,
+           "is_foreign" : go_is_foreign,
            "decl_fixed_blob" : decl_fixed_blob,
            "get_fixed_blobs" : get_fixed_blobs,
            "decl_opaque" : decl_opaque,
            "get_opaque" : get_opaque,
            "decl_vector": decl_vector,
            "get_vectors": get_vectors,
+           "get_vector_names": get_vector_names,
            "is_struct_impl" : is_struct,
            "get_bad_bytes_impl" : get_bad_bytes,
            "is_empty_struct_impl" : is_empty_struct
@@ -259,16 +680,68 @@ def generate_golang(schema):
     result = collections.OrderedDict()
     result_files = collections.OrderedDict()
     result["files"] = result_files
-
-    template_names = [
-        "koinos.go.j2",
-        "koinos_test.go.j2"
-        ]
-
-    for template_name in template_names:
-        j2_template = env.get_template(template_name)
-        out_filename = os.path.splitext(template_name)[0]
-        result_files[out_filename] = j2_template.render(ctx)
+    if not namespaced:
+        package_ctx = package_context("", decls_by_name, go_import_lines(""))
+        ctx.update(package_ctx)
+
+        template_names = [
+            "koinos.go.j2",
+            "koinos_registry.go.j2",
+            "koinos_schema.go.j2"
+            ]
+
+        for template_name in template_names:
+            j2_template = env.get_template(template_name)
+            out_filename = os.path.splitext(template_name)[0]
+            result_files[out_filename] = j2_template.render(ctx)
+
+        render_tests(env, ctx, "", package_ctx, result_files)
+
+        for template_name, groups in golang_decl_templates:
+            if len(decl_template_packages(template_name, groups, decls_by_name)) > 0:
+                result_files[os.path.splitext(template_name)[0]] = env.get_template(template_name).render(ctx)
+    else:
+        packages = sorted(set(package_of.values()) | {""})
+        package_decls = dict((package, collections.OrderedDict((name, decl) for name, decl in decls_by_name.items()
+                                                               if package_of[name] == package)) for package in packages)
+        for package in packages:
+            names = [go_name(cpp_name.split("::")[-1]) for cpp_name in package_decls[package]]
+            duplicates = sorted(set(name for name in names if names.count(name) > 1))
+            if len(duplicates) > 0:
+                raise RenderError("Duplicate names in package %s: %s" % (package_name(package), ", ".join(duplicates)))
+
+        # Template instances live in the package of their element, which may be referenced from any package,
+        # so every package is rendered to collect them, then again to collect the packages it imports
+        j2_template = env.get_template("koinos.go.j2")
+        for _ in range(2):
+            for package in packages:
+                current_package = package
+                j2_template.render(ctx, **package_context(package, package_decls[package], []))
+
+        for package in packages:
+            current_package = package
+            package_ctx = package_context(package, package_decls[package], go_import_lines(package))
+            for template_name in ["koinos.go.j2", "koinos_registry.go.j2", "koinos_schema.go.j2"]:
+                if template_name == "koinos_schema.go.j2" and package != "":
+                    continue
+                out_filename = package_file(package, os.path.splitext(template_name)[0])
+                result_files[out_filename] = env.get_template(template_name).render(ctx, **package_ctx)
+            render_tests(env, ctx, package, package_ctx, result_files)
+
+            helpers = [template_name for template_name, groups in golang_decl_templates
+                       if package in decl_template_packages(template_name, groups, decls_by_name)]
+            for template_name in helpers:
+                out_filename = package_file(package, os.path.splitext(template_name)[0])
+                result_files[out_filename] = env.get_template(template_name).render(ctx, **package_ctx)
+        current_package = ""
+
+    for package, template_name, names in golang_package_templates:
+        if all(name in decls_by_name for name in names):
+            render_package_template(env, ctx, package, template_name, result_files)
+
+    # The fuzz tests seed their corpus with the JSON test data
+    with open(test_data_path, "r") as f:
+        result_files["testdata/test_data.json"] = f.read()
 
     rt_path = os.path.join(os.path.dirname(__file__), "rt")
     for root, dirs, files in os.walk(rt_path):
@@ -276,10 +749,12 @@ def generate_golang(schema):
             filepath = os.path.join(root, f)
             relpath = os.path.relpath(filepath, rt_path)
             with open(filepath, "r") as f:
-                content = f.read()
-            result_files[relpath] = content
+                result_files[relpath] = f.read()
     return result
 
+def generate_golang_namespaced(schema):
+    return generate_golang(schema, namespaced=True)
+
 def escape_json(obj):
    import json
    return json.dumps(obj).replace('"', '\\"')
@@ -301,7 +776,8 @@ def generate_tests(test_data):
         else:
             typename = split_ns[0]
 
-        test_cases.append({"typename": typename, "json": escape_json(test['json'])})
+        name = test['type'] if test['type'].startswith("koinos::") else "koinos::" + test['type']
+        test_cases.append({"typename": typename, "name": name, "json": escape_json(test['json'])})
 
     ctx = {"test_cases" : test_cases,
            "go_name" : go_name
@@ -311,4 +787,5 @@ def generate_tests(test_data):
 
 def setup(app):
     app.register_target("golang", generate_golang)
+    app.register_target("golang_namespaced", generate_golang_namespaced)
     app.register_target("golang_test", generate_tests)
@@ -0,0 +1,269 @@
+package koinos
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/koinos/koinos-types-golang/internal/impl"
+	"github.com/koinos/koinos-types-golang/pretty"
+)
+
+// --------------------------------
+//  Annotated Hex Dump
+// --------------------------------
+
+// Segment is a contiguous run of serialized bytes and the value it encodes
+type Segment struct {
+	Offset int
+	Length int
+	Path   string
+	Value  string
+}
+
+// annotateHexLimit is the number of bytes shown in the hex column of a dump line
+const annotateHexLimit = 16
+
+type annotator struct {
+	data     []byte
+	offset   int
+	segments []Segment
+}
+
+// AnnotateSegments splits the serialization of a registered type into segments, including
+// varint length prefixes, variant tags and opaque length prefixes. The segments decoded
+// before an error are returned along with the error.
+func AnnotateSegments(typeName string, data []byte) ([]Segment, error) {
+	a := annotator{data: data}
+	err := a.walk(typeName, "")
+	if err == nil && a.offset != len(data) {
+		a.emit(len(data)-a.offset, "<trailing>", byteCount(len(data)-a.offset))
+		err = ErrTrailingBytes
+	}
+	return a.segments, err
+}
+
+// Annotate returns a hex dump of the serialization of a registered type, listing the offset, length,
+// bytes, field path and decoded value of every segment. The dump covers everything decoded before an error.
+func Annotate(typeName string, data []byte) (string, error) {
+	segments, err := AnnotateSegments(typeName, data)
+
+	width := 0
+	for _, s := range segments {
+		if n := annotateHexWidth(s.Length); n > width {
+			width = n
+		}
+	}
+
+	var b strings.Builder
+	end := 0
+	for _, s := range segments {
+		end = s.Offset + s.Length
+		h := hex.EncodeToString(data[s.Offset : s.Offset+s.Length])
+		if s.Length > annotateHexLimit {
+			h = h[:2*annotateHexLimit] + "..."
+		}
+		path := s.Path
+		if path == "" {
+			path = "."
+		}
+		fmt.Fprintf(&b, "%06x  %4d  %-*s  %s = %s\n", s.Offset, s.Length, width, h, path, s.Value)
+	}
+	if err != nil {
+		fmt.Fprintf(&b, "%06x  error: %s\n", end, err)
+	}
+	return b.String(), err
+}
+
+func annotateHexWidth(length int) int {
+	if length > annotateHexLimit {
+		return 2*annotateHexLimit + 3
+	}
+	return 2 * length
+}
+
+func (a *annotator) emit(length int, path string, value string) {
+	a.segments = append(a.segments, Segment{Offset: a.offset, Length: length, Path: path, Value: value})
+	a.offset += length
+}
+
+func (a *annotator) uvarint(path string, describe func(uint64) string) (uint64, error) {
+	v, n := impl.Uvarint(a.data[a.offset:])
+	if n <= 0 {
+		return 0, errors.New("Could not decode varint at " + path)
+	}
+	a.emit(n, path, describe(v))
+	return v, nil
+}
+
+func (a *annotator) length(path string) (int, error) {
+	size, err := a.uvarint(joinSuffix(path, "<length>"), func(v uint64) string { return strconv.FormatUint(v, 10) })
+	if err != nil {
+		return 0, err
+	}
+	if uint64(len(a.data)-a.offset) < size {
+		return 0, errors.New("Unexpected EOF at " + path)
+	}
+	return int(size), nil
+}
+
+func (a *annotator) bytes(size int, path string) {
+	a.emit(size, path, byteCount(size))
+}
+
+func byteCount(n int) string {
+	if n == 1 {
+		return "1 byte"
+	}
+	return strconv.Itoa(n) + " bytes"
+}
+
+// value decodes a leaf value with the registered decoder
+func (a *annotator) value(info *TypeInfo, path string) error {
+	vb := VariableBlob(a.data[a.offset:])
+	n, v, err := info.Deserialize(&vb)
+	if err != nil {
+		return fmt.Errorf("%s at %s", err, path)
+	}
+
+	var s string
+	if p, ok := v.(pretty.Printer); ok {
+		s = pretty.Compact(p)
+	} else {
+		s = fmt.Sprint(v)
+	}
+	a.emit(int(n), path, s)
+	return nil
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func joinSuffix(path string, suffix string) string {
+	if path == "" {
+		return suffix
+	}
+	return path + " " + suffix
+}
+
+func (a *annotator) walk(typeName string, path string) error {
+	info, ok := Registry.Lookup(typeName)
+	if !ok {
+		return errors.New("Unknown type: " + typeName)
+	}
+
+	switch info.Kind {
+	case KindStruct:
+		for _, field := range info.Fields {
+			if err := a.walk(field.TypeName, joinPath(path, field.Name)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case KindVariant:
+		var alt string
+		tag, err := a.uvarint(joinSuffix(path, "<tag>"), func(v uint64) string {
+			if v < uint64(len(info.Alternatives)) {
+				alt = info.Alternatives[v]
+				if altInfo, ok := Registry.Lookup(alt); ok {
+					return strconv.FormatUint(v, 10) + " (" + altInfo.GoName + ")"
+				}
+				return strconv.FormatUint(v, 10) + " (" + alt + ")"
+			}
+			return strconv.FormatUint(v, 10) + " (unknown)"
+		})
+		if err != nil {
+			return err
+		}
+		if alt == "" {
+			return fmt.Errorf("Unknown variant tag %d at %s", tag, path)
+		}
+		return a.walk(alt, path)
+
+	case KindTypedef:
+		return a.walk(info.Element, path)
+
+	case KindVector:
+		count, err := a.uvarint(joinSuffix(path, "<length>"), func(v uint64) string {
+			if v == 1 {
+				return "1 item"
+			}
+			return strconv.FormatUint(v, 10) + " items"
+		})
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < count; i++ {
+			if err = a.walk(info.Element, path+"["+strconv.FormatUint(i, 10)+"]"); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case KindOpaque:
+		size, err := a.length(path)
+		if err != nil {
+			return err
+		}
+
+		// Annotate the contents when they decode, otherwise show them as raw bytes
+		inner := annotator{data: a.data[:a.offset+size], offset: a.offset}
+		if err = inner.walk(info.Element, path); err == nil && inner.offset == a.offset+size {
+			a.segments = append(a.segments, inner.segments...)
+			a.offset += size
+		} else {
+			a.bytes(size, joinSuffix(path, "<opaque>"))
+		}
+		return nil
+
+	case KindFixedBlob:
+		if len(a.data)-a.offset < info.Size {
+			return errors.New("Unexpected EOF at " + path)
+		}
+		a.emit(info.Size, path, "0x"+hex.EncodeToString(a.data[a.offset:a.offset+info.Size]))
+		return nil
+	}
+
+	switch typeName {
+	case "koinos::variable_blob":
+		size, err := a.length(path)
+		if err != nil {
+			return err
+		}
+		a.bytes(size, path)
+		return nil
+
+	case "std::string":
+		size, err := a.length(path)
+		if err != nil {
+			return err
+		}
+		s := a.data[a.offset : a.offset+size]
+		if !utf8.Valid(s) {
+			return errors.New("String is not UTF-8 encoded at " + path)
+		}
+		a.emit(size, path, strconv.Quote(string(s)))
+		return nil
+
+	case "koinos::multihash":
+		if _, err := a.uvarint(joinPath(path, "id"), func(v uint64) string { return "0x" + strconv.FormatUint(v, 16) }); err != nil {
+			return err
+		}
+		size, err := a.length(joinPath(path, "digest"))
+		if err != nil {
+			return err
+		}
+		a.bytes(size, joinPath(path, "digest"))
+		return nil
+	}
+
+	return a.value(info, path)
+}
@@ -6,18 +6,23 @@ import (
 	"encoding/json"
 	"errors"
 	"math/big"
-	"sync"
+	"strconv"
 	"unicode/utf8"
 
-	"github.com/btcsuite/btcutil/base58"
+	"github.com/koinos/koinos-types-golang/internal/impl"
 )
 
 const bigIntNumericLiteralMin int64 = -9007199254740991 // -1 << 53
 const bigIntNumericLiteralMax int64 = 9007199254740991  // 1 << 53 - 1
 
 // Serializeable type
+//
+// Serialize appends the serialization to *vb in place and returns vb.
+// AppendBinary appends the serialization to dst and returns the extended slice.
 type Serializeable interface {
 	Serialize(vb *VariableBlob) *VariableBlob
+	AppendBinary(dst []byte) []byte
+	SerializedSize() int
 }
 
 // --------------------------------
@@ -35,15 +40,53 @@ func NewString() *String {
 
 // Serialize String
 func (n *String) Serialize(vb *VariableBlob) *VariableBlob {
-	nvb := VariableBlob(make([]byte, len(*n)))
-	copy(nvb, *n)
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary String
+func (n *String) AppendBinary(dst []byte) []byte {
+	dst = impl.AppendUvarint(dst, uint64(len(*n)))
+	return append(dst, *n...)
+}
+
+// SerializedSize String
+func (n *String) SerializedSize() int {
+	return impl.UvarintSize(uint64(len(*n))) + len(*n)
+}
+
+// MarshalBinary String
+func (n *String) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
 
-	return nvb.Serialize(vb)
+// UnmarshalBinary String
+func (n *String) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeString(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeString function
 func DeserializeString(vb *VariableBlob) (uint64, *String, error) {
-	bytes, vbPtr, err := DeserializeVariableBlob(vb)
+	return deserializeString(vb, CopyDecode)
+}
+
+// DeserializeStringZeroCopy function, the result aliases vb (see ZeroCopyDecode)
+func DeserializeStringZeroCopy(vb *VariableBlob) (uint64, *String, error) {
+	return deserializeString(vb, ZeroCopyDecode)
+}
+
+func deserializeString(vb *VariableBlob, mode DecodeMode) (uint64, *String, error) {
+	bytes, vbPtr, err := deserializeVariableBlob(vb, ZeroCopyDecode)
 	s := String("")
 	if err != nil {
 		return 0, &s, err
@@ -52,7 +95,12 @@ func DeserializeString(vb *VariableBlob) (uint64, *String, error) {
 	if !utf8.Valid(*vbPtr) {
 		return 0, &s, errors.New("String is not UTF-8 encoded")
 	}
-	s = String(*vbPtr)
+
+	if mode == ZeroCopyDecode {
+		s = String(aliasString(*vbPtr))
+	} else {
+		s = String(*vbPtr)
+	}
 
 	return bytes, &s, nil
 }
@@ -72,12 +120,41 @@ func NewBoolean() *Boolean {
 
 // Serialize Boolean
 func (n *Boolean) Serialize(vb *VariableBlob) *VariableBlob {
-	var b byte
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Boolean
+func (n *Boolean) AppendBinary(dst []byte) []byte {
 	if *n {
-		b = 1
+		return append(dst, 1)
+	}
+	return append(dst, 0)
+}
+
+// SerializedSize Boolean
+func (n *Boolean) SerializedSize() int {
+	return 1
+}
+
+// MarshalBinary Boolean
+func (n *Boolean) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Boolean
+func (n *Boolean) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeBoolean(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
 	}
-	x := append(*vb, b)
-	return &x
+
+	*n = *v
+	return nil
 }
 
 // DeserializeBoolean function
@@ -112,8 +189,38 @@ func NewInt8() *Int8 {
 
 // Serialize Int8
 func (n *Int8) Serialize(vb *VariableBlob) *VariableBlob {
-	ov := append(*vb, byte(*n))
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int8
+func (n *Int8) AppendBinary(dst []byte) []byte {
+	return append(dst, byte(*n))
+}
+
+// SerializedSize Int8
+func (n *Int8) SerializedSize() int {
+	return 1
+}
+
+// MarshalBinary Int8
+func (n *Int8) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int8
+func (n *Int8) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt8(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt8 function
@@ -144,8 +251,38 @@ func NewUInt8() *UInt8 {
 
 // Serialize UInt8
 func (n *UInt8) Serialize(vb *VariableBlob) *VariableBlob {
-	ov := append(*vb, byte(*n))
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt8
+func (n *UInt8) AppendBinary(dst []byte) []byte {
+	return append(dst, byte(*n))
+}
+
+// SerializedSize UInt8
+func (n *UInt8) SerializedSize() int {
+	return 1
+}
+
+// MarshalBinary UInt8
+func (n *UInt8) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt8
+func (n *UInt8) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt8(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt8 function
@@ -176,10 +313,39 @@ func NewInt16() *Int16 {
 
 // Serialize Int16
 func (n *Int16) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 2)
-	binary.BigEndian.PutUint16(b, uint16(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int16
+func (n *Int16) AppendBinary(dst []byte) []byte {
+	v := uint16(*n)
+	return append(dst, byte(v>>8), byte(v))
+}
+
+// SerializedSize Int16
+func (n *Int16) SerializedSize() int {
+	return 2
+}
+
+// MarshalBinary Int16
+func (n *Int16) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int16
+func (n *Int16) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt16(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt16 function
@@ -210,10 +376,39 @@ func NewUInt16() *UInt16 {
 
 // Serialize UInt16
 func (n *UInt16) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 2)
-	binary.BigEndian.PutUint16(b, uint16(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt16
+func (n *UInt16) AppendBinary(dst []byte) []byte {
+	v := uint16(*n)
+	return append(dst, byte(v>>8), byte(v))
+}
+
+// SerializedSize UInt16
+func (n *UInt16) SerializedSize() int {
+	return 2
+}
+
+// MarshalBinary UInt16
+func (n *UInt16) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt16
+func (n *UInt16) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt16(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt16 function
@@ -244,10 +439,39 @@ func NewInt32() *Int32 {
 
 // Serialize Int32
 func (n *Int32) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int32
+func (n *Int32) AppendBinary(dst []byte) []byte {
+	v := uint32(*n)
+	return append(dst, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// SerializedSize Int32
+func (n *Int32) SerializedSize() int {
+	return 4
+}
+
+// MarshalBinary Int32
+func (n *Int32) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int32
+func (n *Int32) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt32(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt32 function
@@ -278,10 +502,39 @@ func NewUInt32() *UInt32 {
 
 // Serialize UInt32
 func (n *UInt32) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 4)
-	binary.BigEndian.PutUint32(b, uint32(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt32
+func (n *UInt32) AppendBinary(dst []byte) []byte {
+	v := uint32(*n)
+	return append(dst, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// SerializedSize UInt32
+func (n *UInt32) SerializedSize() int {
+	return 4
+}
+
+// MarshalBinary UInt32
+func (n *UInt32) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt32
+func (n *UInt32) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt32(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt32 function
@@ -312,10 +565,39 @@ func NewInt64() *Int64 {
 
 // Serialize Int64
 func (n *Int64) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int64
+func (n *Int64) AppendBinary(dst []byte) []byte {
+	v := uint64(*n)
+	return append(dst, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// SerializedSize Int64
+func (n *Int64) SerializedSize() int {
+	return 8
+}
+
+// MarshalBinary Int64
+func (n *Int64) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int64
+func (n *Int64) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt64(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt64 function
@@ -346,10 +628,39 @@ func NewUInt64() *UInt64 {
 
 // Serialize UInt64
 func (n *UInt64) Serialize(vb *VariableBlob) *VariableBlob {
-	b := make([]byte, 8)
-	binary.BigEndian.PutUint64(b, uint64(*n))
-	ov := append(*vb, b...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt64
+func (n *UInt64) AppendBinary(dst []byte) []byte {
+	v := uint64(*n)
+	return append(dst, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// SerializedSize UInt64
+func (n *UInt64) SerializedSize() int {
+	return 8
+}
+
+// MarshalBinary UInt64
+func (n *UInt64) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt64
+func (n *UInt64) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt64(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt64 function
@@ -371,112 +682,180 @@ func DeserializeUInt64(vb *VariableBlob) (uint64, *UInt64, error) {
 
 // Int128 type
 type Int128 struct {
-	Value big.Int
+	limbs [2]uint64
 }
 
 var (
-	int128Max  *Int128
-	int128Min  *Int128
-	int128Once sync.Once
+	int128Max = Int128{limbs: [2]uint64{0xFFFFFFFFFFFFFFFF, 0x7FFFFFFFFFFFFFFF}}
+	int128Min = Int128{limbs: [2]uint64{0x0, 0x8000000000000000}}
 )
 
-func initInt128Bounds() {
-	int128Once.Do(func() {
-		int128Max = NewInt128()
-		nv, _ := int128Max.Value.SetString("170141183460469231731687303715884105727", 10)
-		int128Max.Value = *nv
-		int128Min = NewInt128()
-		nv, _ = int128Min.Value.SetString("-170141183460469231731687303715884105728", 10)
-		int128Min.Value = *nv
-	})
-}
-
 // Int128Max upper bound
 func Int128Max() Int128 {
-	initInt128Bounds()
-	return *int128Max
+	return int128Max
 }
 
 // Int128Min lower bound
 func Int128Min() Int128 {
-	initInt128Bounds()
-	return *int128Min
+	return int128Min
 }
 
 // NewInt128 factory
 func NewInt128() *Int128 {
-	result := Int128{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &Int128{}
 }
 
-// NewInt128FromString string factory
+// NewInt128FromString factory
 func NewInt128FromString(value string) (*Int128, error) {
-	var result Int128 = Int128{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse Int128")
 	}
+	if !inRange || !w.fits(128, true) {
+		return nil, errors.New("Int128 is out of bounds")
+	}
+	return newInt128FromWide(&w), nil
+}
 
-	max := Int128Max()
-	min := Int128Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+// NewInt128FromBigInt factory
+func NewInt128FromBigInt(value *big.Int) (*Int128, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(128, true) {
 		return nil, errors.New("Int128 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newInt128FromWide(&w), nil
+}
+
+func newInt128FromWide(w *wide) *Int128 {
+	var result Int128
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *Int128) wide() wide {
+	return wideFromLimbs(n.limbs[:], true)
+}
+
+// BigInt Int128
+func (n *Int128) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String Int128
+func (n Int128) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate Int128
+func (n *Int128) Validate() error {
+	if !validLimbs(n.limbs[:], 128, true) {
+		return errors.New("Int128 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize Int128
 func (n *Int128) Serialize(vb *VariableBlob) *VariableBlob {
-	s := SerializeBigInt(&n.Value, 16, true)
-	ov := append(*vb, *s...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int128
+func (n *Int128) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 16)
+}
+
+// SerializedSize Int128
+func (n *Int128) SerializedSize() int {
+	return 16
+}
+
+// MarshalBinary Int128
+func (n *Int128) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int128
+func (n *Int128) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt128(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt128 function
 func DeserializeInt128(vb *VariableBlob) (uint64, *Int128, error) {
-	biPtr, err := DeserializeBigInt(vb, 16, true)
 	i := Int128{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 16 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:16], true)
 
 	return 16, &i, nil
 }
 
 // MarshalJSON Int128
 func (n Int128) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(&i)
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON Int128
 func (n *Int128) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewInt128FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
+	}
+
+	nv, err := NewInt128FromString(s)
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText Int128
+func (n Int128) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText Int128
+func (n *Int128) UnmarshalText(text []byte) error {
+	nv, err := NewInt128FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
 	return nil
 }
 
@@ -486,112 +865,180 @@ func (n *Int128) UnmarshalJSON(b []byte) error {
 
 // UInt128 type
 type UInt128 struct {
-	Value big.Int
+	limbs [2]uint64
 }
 
 var (
-	uint128Max  *UInt128
-	uint128Min  *UInt128
-	uint128Once sync.Once
+	uint128Max = UInt128{limbs: [2]uint64{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF}}
+	uint128Min = UInt128{limbs: [2]uint64{0x0, 0x0}}
 )
 
-func initUInt128Bounds() {
-	uint128Once.Do(func() {
-		uint128Max = NewUInt128()
-		nv, _ := uint128Max.Value.SetString("340282366920938463463374607431768211455", 10)
-		uint128Max.Value = *nv
-		uint128Min = NewUInt128()
-	})
-}
-
 // UInt128Max upper bound
 func UInt128Max() UInt128 {
-	initUInt128Bounds()
-	return *uint128Max
+	return uint128Max
 }
 
 // UInt128Min lower bound
 func UInt128Min() UInt128 {
-	initUInt128Bounds()
-	return *uint128Min
+	return uint128Min
 }
 
 // NewUInt128 factory
 func NewUInt128() *UInt128 {
-	result := UInt128{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &UInt128{}
 }
 
 // NewUInt128FromString factory
 func NewUInt128FromString(value string) (*UInt128, error) {
-	var result UInt128 = UInt128{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse UInt128")
 	}
-	max := UInt128Max()
-	min := UInt128Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+	if !inRange || !w.fits(128, false) {
+		return nil, errors.New("UInt128 is out of bounds")
+	}
+	return newUInt128FromWide(&w), nil
+}
+
+// NewUInt128FromBigInt factory
+func NewUInt128FromBigInt(value *big.Int) (*UInt128, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(128, false) {
 		return nil, errors.New("UInt128 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newUInt128FromWide(&w), nil
+}
+
+func newUInt128FromWide(w *wide) *UInt128 {
+	var result UInt128
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *UInt128) wide() wide {
+	return wideFromLimbs(n.limbs[:], false)
+}
+
+// BigInt UInt128
+func (n *UInt128) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String UInt128
+func (n UInt128) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate UInt128
+func (n *UInt128) Validate() error {
+	if !validLimbs(n.limbs[:], 128, false) {
+		return errors.New("UInt128 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize UInt128
 func (n *UInt128) Serialize(vb *VariableBlob) *VariableBlob {
-	x := SerializeBigInt(&n.Value, 16, false)
-	ov := append(*vb, *x...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt128
+func (n *UInt128) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 16)
+}
+
+// SerializedSize UInt128
+func (n *UInt128) SerializedSize() int {
+	return 16
+}
+
+// MarshalBinary UInt128
+func (n *UInt128) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt128
+func (n *UInt128) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt128(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt128 function
 func DeserializeUInt128(vb *VariableBlob) (uint64, *UInt128, error) {
-	biPtr, err := DeserializeBigInt(vb, 16, false)
 	i := UInt128{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 16 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:16], false)
 
 	return 16, &i, nil
 }
 
 // MarshalJSON UInt128
 func (n UInt128) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(&i)
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON UInt128
 func (n *UInt128) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		if i < 0 {
-			return errors.New("UInt128 is out of bounds")
-		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewUInt128FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
+	}
+
+	nv, err := NewUInt128FromString(s)
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText UInt128
+func (n UInt128) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText UInt128
+func (n *UInt128) UnmarshalText(text []byte) error {
+	nv, err := NewUInt128FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
 	return nil
 }
 
@@ -601,110 +1048,179 @@ func (n *UInt128) UnmarshalJSON(b []byte) error {
 
 // Int160 type
 type Int160 struct {
-	Value big.Int
+	limbs [3]uint64
 }
 
 var (
-	int160Max  *Int160
-	int160Min  *Int160
-	int160Once sync.Once
+	int160Max = Int160{limbs: [3]uint64{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0x7FFFFFFF}}
+	int160Min = Int160{limbs: [3]uint64{0x0, 0x0, 0xFFFFFFFF80000000}}
 )
 
-func initInt160Bounds() {
-	int160Once.Do(func() {
-		int160Max = NewInt160()
-		nv, _ := int160Max.Value.SetString("730750818665451459101842416358141509827966271487", 10)
-		int160Max.Value = *nv
-		int160Min = NewInt160()
-		nv, _ = int160Min.Value.SetString("-730750818665451459101842416358141509827966271488", 10)
-		int160Min.Value = *nv
-	})
-}
-
 // Int160Max upper bound
 func Int160Max() Int160 {
-	initInt160Bounds()
-	return *int160Max
+	return int160Max
 }
 
 // Int160Min lower bound
 func Int160Min() Int160 {
-	initInt160Bounds()
-	return *int160Min
+	return int160Min
 }
 
 // NewInt160 factory
 func NewInt160() *Int160 {
-	result := Int160{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &Int160{}
 }
 
 // NewInt160FromString factory
 func NewInt160FromString(value string) (*Int160, error) {
-	var result Int160 = Int160{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse Int160")
 	}
-	max := Int160Max()
-	min := Int160Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+	if !inRange || !w.fits(160, true) {
+		return nil, errors.New("Int160 is out of bounds")
+	}
+	return newInt160FromWide(&w), nil
+}
+
+// NewInt160FromBigInt factory
+func NewInt160FromBigInt(value *big.Int) (*Int160, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(160, true) {
 		return nil, errors.New("Int160 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newInt160FromWide(&w), nil
+}
+
+func newInt160FromWide(w *wide) *Int160 {
+	var result Int160
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *Int160) wide() wide {
+	return wideFromLimbs(n.limbs[:], true)
+}
+
+// BigInt Int160
+func (n *Int160) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String Int160
+func (n Int160) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate Int160
+func (n *Int160) Validate() error {
+	if !validLimbs(n.limbs[:], 160, true) {
+		return errors.New("Int160 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize Int160
 func (n *Int160) Serialize(vb *VariableBlob) *VariableBlob {
-	x := SerializeBigInt(&n.Value, 20, true)
-	ov := append(*vb, *x...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int160
+func (n *Int160) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 20)
+}
+
+// SerializedSize Int160
+func (n *Int160) SerializedSize() int {
+	return 20
+}
+
+// MarshalBinary Int160
+func (n *Int160) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int160
+func (n *Int160) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt160(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt160 function
 func DeserializeInt160(vb *VariableBlob) (uint64, *Int160, error) {
-	biPtr, err := DeserializeBigInt(vb, 20, true)
 	i := Int160{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 20 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:20], true)
 
 	return 20, &i, nil
 }
 
 // MarshalJSON Int160
-func (n *Int160) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(i)
+func (n Int160) MarshalJSON() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON Int160
 func (n *Int160) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewInt160FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
+	}
+
+	nv, err := NewInt160FromString(s)
+	if err != nil {
+		return err
 	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText Int160
+func (n Int160) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText Int160
+func (n *Int160) UnmarshalText(text []byte) error {
+	nv, err := NewInt160FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
 
 	return nil
 }
@@ -715,112 +1231,180 @@ func (n *Int160) UnmarshalJSON(b []byte) error {
 
 // UInt160 type
 type UInt160 struct {
-	Value big.Int
+	limbs [3]uint64
 }
 
 var (
-	uint160Max  *UInt160
-	uint160Min  *UInt160
-	uint160Once sync.Once
+	uint160Max = UInt160{limbs: [3]uint64{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFF}}
+	uint160Min = UInt160{limbs: [3]uint64{0x0, 0x0, 0x0}}
 )
 
-func initUInt160Bounds() {
-	uint160Once.Do(func() {
-		uint160Max = NewUInt160()
-		nv, _ := uint160Max.Value.SetString("1461501637330902918203684832716283019655932542975", 10)
-		uint160Max.Value = *nv
-		uint160Min = NewUInt160()
-	})
-}
-
 // UInt160Max upper bound
 func UInt160Max() UInt160 {
-	initUInt160Bounds()
-	return *uint160Max
+	return uint160Max
 }
 
 // UInt160Min lower bound
 func UInt160Min() UInt160 {
-	initUInt160Bounds()
-	return *uint160Min
+	return uint160Min
 }
 
 // NewUInt160 factory
 func NewUInt160() *UInt160 {
-	result := UInt160{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &UInt160{}
 }
 
 // NewUInt160FromString factory
 func NewUInt160FromString(value string) (*UInt160, error) {
-	var result UInt160 = UInt160{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse UInt160")
 	}
-	max := UInt160Max()
-	min := UInt160Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+	if !inRange || !w.fits(160, false) {
+		return nil, errors.New("UInt160 is out of bounds")
+	}
+	return newUInt160FromWide(&w), nil
+}
+
+// NewUInt160FromBigInt factory
+func NewUInt160FromBigInt(value *big.Int) (*UInt160, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(160, false) {
 		return nil, errors.New("UInt160 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newUInt160FromWide(&w), nil
+}
+
+func newUInt160FromWide(w *wide) *UInt160 {
+	var result UInt160
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *UInt160) wide() wide {
+	return wideFromLimbs(n.limbs[:], false)
+}
+
+// BigInt UInt160
+func (n *UInt160) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String UInt160
+func (n UInt160) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate UInt160
+func (n *UInt160) Validate() error {
+	if !validLimbs(n.limbs[:], 160, false) {
+		return errors.New("UInt160 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize UInt160
 func (n *UInt160) Serialize(vb *VariableBlob) *VariableBlob {
-	x := SerializeBigInt(&n.Value, 20, false)
-	ov := append(*vb, *x...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt160
+func (n *UInt160) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 20)
+}
+
+// SerializedSize UInt160
+func (n *UInt160) SerializedSize() int {
+	return 20
+}
+
+// MarshalBinary UInt160
+func (n *UInt160) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt160
+func (n *UInt160) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt160(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt160 function
 func DeserializeUInt160(vb *VariableBlob) (uint64, *UInt160, error) {
-	biPtr, err := DeserializeBigInt(vb, 20, false)
 	i := UInt160{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 20 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:20], false)
 
 	return 20, &i, nil
 }
 
 // MarshalJSON UInt160
 func (n UInt160) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(&i)
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON UInt160
 func (n *UInt160) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		if i < 0 {
-			return errors.New("UInt160 is out of bounds")
-		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewUInt160FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
+	}
+
+	nv, err := NewUInt160FromString(s)
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText UInt160
+func (n UInt160) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText UInt160
+func (n *UInt160) UnmarshalText(text []byte) error {
+	nv, err := NewUInt160FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
 	return nil
 }
 
@@ -830,110 +1414,179 @@ func (n *UInt160) UnmarshalJSON(b []byte) error {
 
 // Int256 type
 type Int256 struct {
-	Value big.Int
+	limbs [4]uint64
 }
 
 var (
-	int256Max  *Int256
-	int256Min  *Int256
-	int256Once sync.Once
+	int256Max = Int256{limbs: [4]uint64{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0x7FFFFFFFFFFFFFFF}}
+	int256Min = Int256{limbs: [4]uint64{0x0, 0x0, 0x0, 0x8000000000000000}}
 )
 
-func initInt256Bounds() {
-	int256Once.Do(func() {
-		int256Max = NewInt256()
-		nv, _ := int256Max.Value.SetString("57896044618658097711785492504343953926634992332820282019728792003956564819967", 10)
-		int256Max.Value = *nv
-		int256Min = NewInt256()
-		nv, _ = int256Min.Value.SetString("-57896044618658097711785492504343953926634992332820282019728792003956564819968", 10)
-		int256Min.Value = *nv
-	})
-}
-
 // Int256Max upper bound
 func Int256Max() Int256 {
-	initInt256Bounds()
-	return *int256Max
+	return int256Max
 }
 
 // Int256Min lower bound
 func Int256Min() Int256 {
-	initInt256Bounds()
-	return *int256Min
+	return int256Min
 }
 
 // NewInt256 factory
 func NewInt256() *Int256 {
-	result := Int256{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &Int256{}
 }
 
 // NewInt256FromString factory
 func NewInt256FromString(value string) (*Int256, error) {
-	var result Int256 = Int256{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse Int256")
 	}
-	max := Int256Max()
-	min := Int256Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+	if !inRange || !w.fits(256, true) {
+		return nil, errors.New("Int256 is out of bounds")
+	}
+	return newInt256FromWide(&w), nil
+}
+
+// NewInt256FromBigInt factory
+func NewInt256FromBigInt(value *big.Int) (*Int256, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(256, true) {
 		return nil, errors.New("Int256 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newInt256FromWide(&w), nil
+}
+
+func newInt256FromWide(w *wide) *Int256 {
+	var result Int256
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *Int256) wide() wide {
+	return wideFromLimbs(n.limbs[:], true)
+}
+
+// BigInt Int256
+func (n *Int256) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String Int256
+func (n Int256) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate Int256
+func (n *Int256) Validate() error {
+	if !validLimbs(n.limbs[:], 256, true) {
+		return errors.New("Int256 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize Int256
 func (n *Int256) Serialize(vb *VariableBlob) *VariableBlob {
-	x := SerializeBigInt(&n.Value, 32, true)
-	ov := append(*vb, *x...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Int256
+func (n *Int256) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 32)
+}
+
+// SerializedSize Int256
+func (n *Int256) SerializedSize() int {
+	return 32
+}
+
+// MarshalBinary Int256
+func (n *Int256) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary Int256
+func (n *Int256) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeInt256(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeInt256 function
 func DeserializeInt256(vb *VariableBlob) (uint64, *Int256, error) {
-	biPtr, err := DeserializeBigInt(vb, 32, true)
 	i := Int256{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 32 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:32], true)
 
 	return 32, &i, nil
 }
 
 // MarshalJSON Int256
 func (n Int256) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(&i)
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON Int256
 func (n *Int256) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewInt256FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
+	}
+
+	nv, err := NewInt256FromString(s)
+	if err != nil {
+		return err
 	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText Int256
+func (n Int256) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText Int256
+func (n *Int256) UnmarshalText(text []byte) error {
+	nv, err := NewInt256FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
 
 	return nil
 }
@@ -944,112 +1597,180 @@ func (n *Int256) UnmarshalJSON(b []byte) error {
 
 // UInt256 type
 type UInt256 struct {
-	Value big.Int
+	limbs [4]uint64
 }
 
 var (
-	uint256Max  *UInt256
-	uint256Min  *UInt256
-	uint256Once sync.Once
+	uint256Max = UInt256{limbs: [4]uint64{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF}}
+	uint256Min = UInt256{limbs: [4]uint64{0x0, 0x0, 0x0, 0x0}}
 )
 
-func initUInt256Bounds() {
-	uint256Once.Do(func() {
-		uint256Max = NewUInt256()
-		nv, _ := uint256Max.Value.SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
-		uint256Max.Value = *nv
-		uint256Min = NewUInt256()
-	})
-}
-
 // UInt256Max upper bound
 func UInt256Max() UInt256 {
-	initUInt256Bounds()
-	return *uint256Max
+	return uint256Max
 }
 
 // UInt256Min lower bound
 func UInt256Min() UInt256 {
-	initUInt256Bounds()
-	return *uint256Min
+	return uint256Min
 }
 
 // NewUInt256 factory
 func NewUInt256() *UInt256 {
-	result := UInt256{}
-	result.Value = *big.NewInt(0)
-	return &result
+	return &UInt256{}
 }
 
 // NewUInt256FromString factory
 func NewUInt256FromString(value string) (*UInt256, error) {
-	var result UInt256 = UInt256{}
-	nv, ok := result.Value.SetString(value, 10)
-	if ok == false {
+	w, ok, inRange := parseWide(value, 10)
+	if !ok {
 		return nil, errors.New("Could not parse UInt256")
 	}
-	max := UInt256Max()
-	min := UInt256Min()
-	if nv.Cmp(&max.Value) == 1 || nv.Cmp(&min.Value) == -1 {
+	if !inRange || !w.fits(256, false) {
 		return nil, errors.New("UInt256 is out of bounds")
 	}
-	result.Value = *nv
-	return &result, nil
+	return newUInt256FromWide(&w), nil
+}
+
+// NewUInt256FromBigInt factory
+func NewUInt256FromBigInt(value *big.Int) (*UInt256, error) {
+	w, ok := wideFromBigInt(value)
+	if !ok || !w.fits(256, false) {
+		return nil, errors.New("UInt256 is out of bounds")
+	}
+	return newUInt256FromWide(&w), nil
+}
+
+func newUInt256FromWide(w *wide) *UInt256 {
+	var result UInt256
+	w.store(result.limbs[:])
+	return &result
+}
+
+func (n *UInt256) wide() wide {
+	return wideFromLimbs(n.limbs[:], false)
+}
+
+// BigInt UInt256
+func (n *UInt256) BigInt() *big.Int {
+	w := n.wide()
+	return w.bigInt()
+}
+
+// String UInt256
+func (n UInt256) String() string {
+	w := n.wide()
+	return string(w.appendDecimal(nil))
+}
+
+// Validate UInt256
+func (n *UInt256) Validate() error {
+	if !validLimbs(n.limbs[:], 256, false) {
+		return errors.New("UInt256 is out of bounds")
+	}
+	return nil
 }
 
 // Serialize UInt256
 func (n *UInt256) Serialize(vb *VariableBlob) *VariableBlob {
-	x := SerializeBigInt(&n.Value, 32, false)
-	ov := append(*vb, *x...)
-	return &ov
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary UInt256
+func (n *UInt256) AppendBinary(dst []byte) []byte {
+	if n.Validate() != nil {
+		panic("Attempting to serialize an invalid value")
+	}
+	return appendLimbs(dst, n.limbs[:], 32)
+}
+
+// SerializedSize UInt256
+func (n *UInt256) SerializedSize() int {
+	return 32
+}
+
+// MarshalBinary UInt256
+func (n *UInt256) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary UInt256
+func (n *UInt256) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeUInt256(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeUInt256 function
 func DeserializeUInt256(vb *VariableBlob) (uint64, *UInt256, error) {
-	biPtr, err := DeserializeBigInt(vb, 32, false)
 	i := UInt256{}
 
-	if err != nil {
-		return 0, &i, err
+	if len(*vb) < 32 {
+		return 0, &i, errors.New("Unexpected EOF")
 	}
 
-	i.Value = *biPtr
+	readLimbs(i.limbs[:], (*vb)[:32], false)
 
 	return 32, &i, nil
 }
 
 // MarshalJSON UInt256
 func (n UInt256) MarshalJSON() ([]byte, error) {
-	if i := n.Value.Int64(); n.Value.IsInt64() && i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
-		return json.Marshal(&i)
+	if err := n.Validate(); err != nil {
+		return nil, err
 	}
 
-	s := n.Value.String()
-	return json.Marshal(s)
+	w := n.wide()
+	return marshalWideJSON(&w)
 }
 
 // UnmarshalJSON UInt256
 func (n *UInt256) UnmarshalJSON(b []byte) error {
 	var s string
-	err := json.Unmarshal(b, &s)
-
-	if err != nil {
+	if err := json.Unmarshal(b, &s); err != nil {
 		var i int64
 		if err = json.Unmarshal(b, &i); err != nil {
 			return err
 		}
-		if i < 0 {
-			return errors.New("UInt256 is out of bounds")
-		}
-		n.Value = *big.NewInt(i)
-	} else {
-		nv, err := NewUInt256FromString(s)
-		if err != nil {
-			return err
-		}
-		*n = *nv
+		s = strconv.FormatInt(i, 10)
 	}
 
+	nv, err := NewUInt256FromString(s)
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
+	return nil
+}
+
+// MarshalText UInt256
+func (n UInt256) MarshalText() ([]byte, error) {
+	if err := n.Validate(); err != nil {
+		return nil, err
+	}
+
+	w := n.wide()
+	return w.appendDecimal(nil), nil
+}
+
+// UnmarshalText UInt256
+func (n *UInt256) UnmarshalText(text []byte) error {
+	nv, err := NewUInt256FromString(string(text))
+	if err != nil {
+		return err
+	}
+	*n = *nv
+
 	return nil
 }
 
@@ -1061,41 +1782,87 @@ func (n *UInt256) UnmarshalJSON(b []byte) error {
 type VariableBlob []byte
 
 // NewVariableBlob factory
-// TODO: Make this variadic for size and size_hint
 func NewVariableBlob() *VariableBlob {
 	vb := VariableBlob(make([]byte, 0))
 	return &vb
 }
 
+// NewVariableBlobWithCapacity factory
+func NewVariableBlobWithCapacity(capacity int) *VariableBlob {
+	vb := VariableBlob(make([]byte, 0, capacity))
+	return &vb
+}
+
 // Serialize VariableBlob
 func (n *VariableBlob) Serialize(vb *VariableBlob) *VariableBlob {
-	header := make([]byte, binary.MaxVarintLen64)
-	bytes := binary.PutUvarint(header, uint64(len(*n)))
-	ovb := append(*vb, header[:bytes]...)
-	ovb = append(ovb, *n...)
-	return &ovb
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary VariableBlob
+func (n *VariableBlob) AppendBinary(dst []byte) []byte {
+	dst = impl.AppendUvarint(dst, uint64(len(*n)))
+	return append(dst, *n...)
+}
+
+// SerializedSize VariableBlob
+func (n *VariableBlob) SerializedSize() int {
+	return impl.UvarintSize(uint64(len(*n))) + len(*n)
+}
+
+// MarshalBinary VariableBlob
+func (n *VariableBlob) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary VariableBlob
+func (n *VariableBlob) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeVariableBlob(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeVariableBlob function
 func DeserializeVariableBlob(vb *VariableBlob) (uint64, *VariableBlob, error) {
-	size, bytes := binary.Uvarint(*vb)
-	var result VariableBlob = VariableBlob(make([]byte, 0, size))
+	return deserializeVariableBlob(vb, CopyDecode)
+}
+
+// DeserializeVariableBlobZeroCopy function, the result aliases vb (see ZeroCopyDecode)
+func DeserializeVariableBlobZeroCopy(vb *VariableBlob) (uint64, *VariableBlob, error) {
+	return deserializeVariableBlob(vb, ZeroCopyDecode)
+}
+
+func deserializeVariableBlob(vb *VariableBlob, mode DecodeMode) (uint64, *VariableBlob, error) {
+	var result VariableBlob
+	size, bytes := impl.Uvarint(*vb)
 	if bytes <= 0 {
 		return 0, &result, errors.New("Could not deserialize variable blob size")
 	}
 
-	if len(*vb) < bytes+int(size) {
+	if uint64(len(*vb)-bytes) < size {
 		return 0, &result, errors.New("Unexpected EOF")
 	}
 
-	ovb := append(result, (*vb)[bytes:uint64(bytes)+size]...)
-	return uint64(uint64(bytes) + size), &ovb, nil
+	end := uint64(bytes) + size
+	if mode == ZeroCopyDecode {
+		result = (*vb)[bytes:end:end]
+	} else {
+		result = append(make([]byte, 0, size), (*vb)[bytes:end]...)
+	}
+	return end, &result, nil
 }
 
 // MarshalJSON VariableBlob
 func (n VariableBlob) MarshalJSON() ([]byte, error) {
-	s := EncodeBytes(n)
-	return json.Marshal(s)
+	return json.Marshal(encodeJSONBytes(n))
 }
 
 // UnmarshalJSON VariabeBlob
@@ -1105,13 +1872,20 @@ func (n *VariableBlob) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	db, err := DecodeBytes(s)
+	return n.UnmarshalText([]byte(s))
+}
+
+// MarshalText VariableBlob
+func (n VariableBlob) MarshalText() ([]byte, error) {
+	return []byte(encodeJSONBytes(n)), nil
+}
+
+// UnmarshalText VariableBlob
+func (n *VariableBlob) UnmarshalText(text []byte) error {
+	db, err := DecodeBytes(string(text))
 	if err != nil {
 		return err
 	}
-	if len(db) == 0 && len(s[1:]) > 0 {
-		return errors.New("Unable to decode base58")
-	}
 
 	*n = db
 	return nil
@@ -1132,8 +1906,39 @@ func NewTimestampType() *TimestampType {
 
 // Serialize TimestampType
 func (n *TimestampType) Serialize(vb *VariableBlob) *VariableBlob {
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary TimestampType
+func (n *TimestampType) AppendBinary(dst []byte) []byte {
 	un := UInt64(*n)
-	return un.Serialize(vb)
+	return un.AppendBinary(dst)
+}
+
+// SerializedSize TimestampType
+func (n *TimestampType) SerializedSize() int {
+	return 8
+}
+
+// MarshalBinary TimestampType
+func (n *TimestampType) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary TimestampType
+func (n *TimestampType) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeTimestampType(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeTimestampType function
@@ -1158,8 +1963,39 @@ func NewBlockHeightType() *BlockHeightType {
 
 // Serialize BlockHeightType
 func (n *BlockHeightType) Serialize(vb *VariableBlob) *VariableBlob {
+	*vb = n.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary BlockHeightType
+func (n *BlockHeightType) AppendBinary(dst []byte) []byte {
 	un := UInt64(*n)
-	return un.Serialize(vb)
+	return un.AppendBinary(dst)
+}
+
+// SerializedSize BlockHeightType
+func (n *BlockHeightType) SerializedSize() int {
+	return 8
+}
+
+// MarshalBinary BlockHeightType
+func (n *BlockHeightType) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(n)
+}
+
+// UnmarshalBinary BlockHeightType
+func (n *BlockHeightType) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeBlockHeightType(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*n = *v
+	return nil
 }
 
 // DeserializeBlockHeightType function
@@ -1210,19 +2046,59 @@ func (m0 *Multihash) GreaterThan(m1 *Multihash) bool {
 
 // Serialize Multihash
 func (m0 *Multihash) Serialize(vb *VariableBlob) *VariableBlob {
-	vb = EncodeVarint(vb, uint64(m0.ID))
-	return m0.Digest.Serialize(vb)
+	*vb = m0.AppendBinary(*vb)
+	return vb
+}
+
+// AppendBinary Multihash
+func (m0 *Multihash) AppendBinary(dst []byte) []byte {
+	dst = impl.AppendUvarint(dst, uint64(m0.ID))
+	return m0.Digest.AppendBinary(dst)
+}
+
+// SerializedSize Multihash
+func (m0 *Multihash) SerializedSize() int {
+	return impl.UvarintSize(uint64(m0.ID)) + m0.Digest.SerializedSize()
+}
+
+// MarshalBinary Multihash
+func (m0 *Multihash) MarshalBinary() ([]byte, error) {
+	return impl.MarshalBinary(m0)
+}
+
+// UnmarshalBinary Multihash
+func (m0 *Multihash) UnmarshalBinary(data []byte) error {
+	vb := VariableBlob(data)
+	i, v, err := DeserializeMultihash(&vb)
+	if err != nil {
+		return err
+	}
+	if i != uint64(len(data)) {
+		return ErrTrailingBytes
+	}
+
+	*m0 = *v
+	return nil
 }
 
 // DeserializeMultihash function
 func DeserializeMultihash(vb *VariableBlob) (uint64, *Multihash, error) {
+	return deserializeMultihash(vb, CopyDecode)
+}
+
+// DeserializeMultihashZeroCopy function, the digest aliases vb (see ZeroCopyDecode)
+func DeserializeMultihashZeroCopy(vb *VariableBlob) (uint64, *Multihash, error) {
+	return deserializeMultihash(vb, ZeroCopyDecode)
+}
+
+func deserializeMultihash(vb *VariableBlob, mode DecodeMode) (uint64, *Multihash, error) {
 	omh := Multihash{}
-	id, isize := binary.Uvarint(*vb)
+	id, isize := impl.Uvarint(*vb)
 	if isize <= 0 {
 		return 0, &omh, errors.New("Could not deserialize multihash id")
 	}
 	rvb := (*vb)[isize:]
-	dsize, d, err := DeserializeVariableBlob(&rvb)
+	dsize, d, err := deserializeVariableBlob(&rvb, mode)
 	if err != nil {
 		return 0, &omh, err
 	}
@@ -1233,10 +2109,7 @@ func DeserializeMultihash(vb *VariableBlob) (uint64, *Multihash, error) {
 
 // MarshalJSON Multihash
 func (m0 Multihash) MarshalJSON() ([]byte, error) {
-	vb := NewVariableBlob()
-	s := m0.Serialize(vb)
-	b58str := "z" + base58.Encode(*s)
-	return json.Marshal(b58str)
+	return json.Marshal(encodeJSONBytes(m0.AppendBinary(nil)))
 }
 
 // UnmarshalJSON Multihash
@@ -1246,7 +2119,17 @@ func (m0 *Multihash) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	db, err := DecodeBytes(s)
+	return m0.UnmarshalText([]byte(s))
+}
+
+// MarshalText Multihash
+func (m0 Multihash) MarshalText() ([]byte, error) {
+	return []byte(encodeJSONBytes(m0.AppendBinary(nil))), nil
+}
+
+// UnmarshalText Multihash
+func (m0 *Multihash) UnmarshalText(text []byte) error {
+	db, err := DecodeBytes(string(text))
 	if err != nil {
 		return err
 	}
@@ -1267,25 +2150,6 @@ func (m0 *Multihash) UnmarshalJSON(b []byte) error {
 //  Utility Functions
 // --------------------------------
 
-// EncodeBytes utility function
-func EncodeBytes(b []byte) string {
-	return "z" + base58.Encode(b)
-}
-
-// DecodeBytes utility function
-func DecodeBytes(s string) ([]byte, error) {
-	if len(s) <= 1 {
-		return make([]byte, 0), nil
-	}
-
-	switch s[0] {
-	case 'z':
-		return base58.Decode(s[1:]), nil
-	default:
-		return nil, errors.New("Unknown encoding: " + string(s[0]))
-	}
-}
-
 // SerializeBigInt helper function
 func SerializeBigInt(num *big.Int, byteSize int, signed bool) *VariableBlob {
 	v := VariableBlob(make([]byte, byteSize))
@@ -1326,8 +2190,6 @@ func DeserializeBigInt(vb *VariableBlob, byteSize int, signed bool) (*big.Int, e
 
 // EncodeVarint utility function
 func EncodeVarint(vb *VariableBlob, value uint64) *VariableBlob {
-	header := make([]byte, binary.MaxVarintLen64)
-	bytes := binary.PutUvarint(header, value)
-	*vb = append(*vb, header[:bytes]...)
+	*vb = impl.AppendUvarint(*vb, value)
 	return vb
 }
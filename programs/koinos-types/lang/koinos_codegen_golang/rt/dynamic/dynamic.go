@@ -0,0 +1,898 @@
+// Package dynamic encodes and decodes koinos types described by a koinos_reflect
+// schema at runtime, without generated code.
+//
+// Values are represented as a generic tree:
+//
+//	boolean                          bool
+//	int8 ... int64                   int64
+//	uint8 ... uint64                 uint64
+//	timestamp_type                   uint64
+//	block_height_type                uint64
+//	int128 ... uint256               *big.Int
+//	std::string                      string
+//	variable_blob, fixed_blob<N>     []byte
+//	multihash                        map[string]interface{}{"id": uint64, "digest": []byte}
+//	struct                           map[string]interface{} keyed by field name
+//	std::vector<T>                   []interface{}
+//	std::optional<T>                 nil or the contained value
+//	std::variant<...>                map[string]interface{}{"type": string, "value": interface{}}
+//	enum                             the value of the underlying integer type
+//	opaque<T>                        the contained value, or
+//	                                 map[string]interface{}{"opaque": {"type": string, "value": []byte}}
+//	                                 when the blob does not decode as T
+//
+// Encode also accepts the values produced by encoding/json: float64 and
+// json.Number for integers, decimal strings for wide integers, multibase strings
+// for blobs and multihashes, and enum entry names. Structs must hold exactly their
+// fields and variants both their type and value.
+package dynamic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/koinos/koinos-types-golang"
+	"github.com/koinos/koinos-types-golang/internal/impl"
+)
+
+type schemaInfo struct {
+	Type string `json:"type"`
+}
+
+type typeRef struct {
+	Name  []string   `json:"name"`
+	Targs []typeRef  `json:"targs"`
+	Value int64      `json:"value"`
+	Info  schemaInfo `json:"info"`
+}
+
+type field struct {
+	Name string  `json:"name"`
+	Tref typeRef `json:"tref"`
+}
+
+type entry struct {
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+type decl struct {
+	Fields  []field    `json:"fields"`
+	Tref    *typeRef   `json:"tref"`
+	Entries []entry    `json:"entries"`
+	Info    schemaInfo `json:"info"`
+}
+
+// Schema holds the declarations of a koinos_reflect schema
+type Schema struct {
+	decls map[string]*decl
+	names []string
+}
+
+// LoadSchema parses a schema produced by koinos_reflect.analyze
+func LoadSchema(data []byte) (*Schema, error) {
+	var root struct {
+		Decls [][]json.RawMessage `json:"decls"`
+	}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+
+	s := Schema{decls: make(map[string]*decl)}
+	for _, pair := range root.Decls {
+		if len(pair) != 2 {
+			return nil, errors.New("Malformed schema declaration")
+		}
+
+		var name []string
+		if err := json.Unmarshal(pair[0], &name); err != nil {
+			return nil, err
+		}
+
+		var d decl
+		if err := json.Unmarshal(pair[1], &d); err != nil {
+			return nil, err
+		}
+
+		fq := strings.Join(name, "::")
+		s.decls[fq] = &d
+		s.names = append(s.names, fq)
+	}
+	sort.Strings(s.names)
+
+	return &s, nil
+}
+
+// Names returns the fully qualified name of every declaration in sorted order
+func (s *Schema) Names() []string {
+	names := make([]string, len(s.names))
+	copy(names, s.names)
+	return names
+}
+
+// Encode serializes a value tree as the named type.
+// Template instances such as "std::vector<koinos::multihash>" are accepted.
+func (s *Schema) Encode(typeName string, value interface{}) ([]byte, error) {
+	t, err := parseTypeName(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	vb := koinos.NewVariableBlob()
+	vb, err = s.encode(t, value, vb)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(*vb), nil
+}
+
+// Decode deserializes the named type from the front of data, returning the number of bytes consumed
+func (s *Schema) Decode(typeName string, data []byte) (uint64, interface{}, error) {
+	t, err := parseTypeName(typeName)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.decode(t, data)
+}
+
+// --------------------------------
+//  Type names
+// --------------------------------
+
+func parseTypeName(name string) (*typeRef, error) {
+	t, rest, err := parseTypeRef(strings.ReplaceAll(name, " ", ""))
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("Malformed type name: " + name)
+	}
+	return t, nil
+}
+
+func parseTypeRef(s string) (*typeRef, string, error) {
+	end := strings.IndexAny(s, "<>,")
+	if end < 0 {
+		end = len(s)
+	}
+	if end == 0 {
+		return nil, s, errors.New("Malformed type name")
+	}
+
+	t := typeRef{}
+	if n, err := strconv.ParseInt(s[:end], 10, 64); err == nil {
+		t.Info.Type = "IntLiteral"
+		t.Value = n
+		return &t, s[end:], nil
+	}
+
+	t.Info.Type = "Typeref"
+	t.Name = strings.Split(s[:end], "::")
+	s = s[end:]
+
+	if len(s) == 0 || s[0] != '<' {
+		return &t, s, nil
+	}
+
+	s = s[1:]
+	for {
+		arg, rest, err := parseTypeRef(s)
+		if err != nil {
+			return nil, s, err
+		}
+		t.Targs = append(t.Targs, *arg)
+		if len(rest) == 0 {
+			return nil, rest, errors.New("Malformed type name")
+		}
+		s = rest[1:]
+		if rest[0] == '>' {
+			return &t, s, nil
+		}
+		if rest[0] != ',' {
+			return nil, rest, errors.New("Malformed type name")
+		}
+	}
+}
+
+func typeName(t *typeRef) string {
+	if t.Info.Type == "IntLiteral" {
+		return strconv.FormatInt(t.Value, 10)
+	}
+
+	name := strings.Join(t.Name, "::")
+	if len(t.Targs) > 0 {
+		args := make([]string, len(t.Targs))
+		for i := range t.Targs {
+			args[i] = typeName(&t.Targs[i])
+		}
+		name += "<" + strings.Join(args, ",") + ">"
+	}
+	return name
+}
+
+func (t *typeRef) targ(i int) (*typeRef, error) {
+	if i >= len(t.Targs) {
+		return nil, errors.New("Missing template argument for " + typeName(t))
+	}
+	return &t.Targs[i], nil
+}
+
+// --------------------------------
+//  Integers
+// --------------------------------
+
+type intInfo struct {
+	size   int
+	signed bool
+}
+
+var intTypes = map[string]intInfo{
+	"koinos::int8":              {1, true},
+	"koinos::uint8":             {1, false},
+	"koinos::int16":             {2, true},
+	"koinos::uint16":            {2, false},
+	"koinos::int32":             {4, true},
+	"koinos::uint32":            {4, false},
+	"koinos::int64":             {8, true},
+	"koinos::uint64":            {8, false},
+	"koinos::int128":            {16, true},
+	"koinos::uint128":           {16, false},
+	"koinos::int160":            {20, true},
+	"koinos::uint160":           {20, false},
+	"koinos::int256":            {32, true},
+	"koinos::uint256":           {32, false},
+	"koinos::timestamp_type":    {8, false},
+	"koinos::block_height_type": {8, false},
+}
+
+func (i intInfo) bounds() (*big.Int, *big.Int) {
+	bits := uint(i.size * 8)
+	if i.signed {
+		max := new(big.Int).Lsh(big.NewInt(1), bits-1)
+		min := new(big.Int).Neg(max)
+		return min, max.Sub(max, big.NewInt(1))
+	}
+	max := new(big.Int).Lsh(big.NewInt(1), bits)
+	return big.NewInt(0), max.Sub(max, big.NewInt(1))
+}
+
+func toBigInt(v interface{}) (*big.Int, error) {
+	switch x := v.(type) {
+	case int:
+		return big.NewInt(int64(x)), nil
+	case int8:
+		return big.NewInt(int64(x)), nil
+	case int16:
+		return big.NewInt(int64(x)), nil
+	case int32:
+		return big.NewInt(int64(x)), nil
+	case int64:
+		return big.NewInt(x), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(x)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(x)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(x)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(x)), nil
+	case uint64:
+		return new(big.Int).SetUint64(x), nil
+	case float64:
+		if x != math.Trunc(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("%v is not an integer", x)
+		}
+		b, _ := big.NewFloat(x).Int(nil)
+		return b, nil
+	case json.Number:
+		return toBigInt(string(x))
+	case string:
+		b, ok := new(big.Int).SetString(x, 10)
+		if !ok {
+			return nil, errors.New("Could not parse integer: " + x)
+		}
+		return b, nil
+	case *big.Int:
+		return new(big.Int).Set(x), nil
+	case big.Int:
+		return new(big.Int).Set(&x), nil
+	default:
+		return nil, fmt.Errorf("Expected an integer, got %T", v)
+	}
+}
+
+// toFixedInt converts v to an integer of at most 64 bits, as its two's complement bits
+func toFixedInt(info intInfo, v interface{}) (uint64, error) {
+	var i int64
+	var u uint64
+	negative := false
+
+	switch x := v.(type) {
+	case int:
+		i, negative = int64(x), x < 0
+	case int8:
+		i, negative = int64(x), x < 0
+	case int16:
+		i, negative = int64(x), x < 0
+	case int32:
+		i, negative = int64(x), x < 0
+	case int64:
+		i, negative = x, x < 0
+	case uint:
+		u = uint64(x)
+	case uint8:
+		u = uint64(x)
+	case uint16:
+		u = uint64(x)
+	case uint32:
+		u = uint64(x)
+	case uint64:
+		u = x
+	case float64:
+		if x != math.Trunc(x) || math.IsInf(x, 0) {
+			return 0, fmt.Errorf("%v is not an integer", x)
+		}
+		if x < -(1<<63) || x >= 1<<64 {
+			return 0, fmt.Errorf("%v is out of bounds", x)
+		}
+		if x < 0 {
+			i, negative = int64(x), true
+		} else {
+			u = uint64(x)
+		}
+	case json.Number:
+		return toFixedInt(info, string(x))
+	case string:
+		var err error
+		if strings.HasPrefix(x, "-") {
+			i, err = strconv.ParseInt(x, 10, 64)
+			negative = true
+		} else {
+			u, err = strconv.ParseUint(x, 10, 64)
+		}
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			return 0, errors.New(x + " is out of bounds")
+		} else if err != nil {
+			return 0, errors.New("Could not parse integer: " + x)
+		}
+	case *big.Int, big.Int:
+		n, _ := toBigInt(x)
+		if n.Sign() < 0 && n.IsInt64() {
+			i, negative = n.Int64(), true
+		} else if n.IsUint64() {
+			u = n.Uint64()
+		} else {
+			return 0, fmt.Errorf("%s is out of bounds", n.String())
+		}
+	default:
+		return 0, fmt.Errorf("Expected an integer, got %T", v)
+	}
+
+	if !negative {
+		u = uint64(i) | u
+	}
+
+	bits := uint(info.size * 8)
+	switch {
+	case negative && (!info.signed || i < -1<<(bits-1)):
+		return 0, fmt.Errorf("%d is out of bounds", i)
+	case negative:
+		return uint64(i), nil
+	case info.signed && u > 1<<(bits-1)-1, !info.signed && bits < 64 && u > 1<<bits-1:
+		return 0, fmt.Errorf("%d is out of bounds", u)
+	}
+	return u, nil
+}
+
+func encodeInt(info intInfo, v interface{}, vb *koinos.VariableBlob) (*koinos.VariableBlob, error) {
+	if info.size <= 8 {
+		x, err := toFixedInt(info, v)
+		if err != nil {
+			return vb, err
+		}
+		ovb := *vb
+		for shift := info.size*8 - 8; shift >= 0; shift -= 8 {
+			ovb = append(ovb, byte(x>>uint(shift)))
+		}
+		return &ovb, nil
+	}
+
+	n, err := toBigInt(v)
+	if err != nil {
+		return vb, err
+	}
+
+	min, max := info.bounds()
+	if n.Cmp(min) < 0 || n.Cmp(max) > 0 {
+		return vb, fmt.Errorf("%s is out of bounds", n.String())
+	}
+
+	b := koinos.SerializeBigInt(n, info.size, info.signed)
+	ovb := append(*vb, *b...)
+	return &ovb, nil
+}
+
+func decodeInt(info intInfo, data []byte) (uint64, interface{}, error) {
+	if len(data) < info.size {
+		return 0, nil, errors.New("Unexpected EOF")
+	}
+
+	if info.size <= 8 {
+		var x uint64
+		for _, b := range data[:info.size] {
+			x = x<<8 | uint64(b)
+		}
+		if info.signed {
+			shift := uint(64 - info.size*8)
+			return uint64(info.size), int64(x<<shift) >> shift, nil
+		}
+		return uint64(info.size), x, nil
+	}
+
+	vb := koinos.VariableBlob(data)
+	n, err := koinos.DeserializeBigInt(&vb, info.size, info.signed)
+	if err != nil {
+		return 0, nil, err
+	}
+	return uint64(info.size), n, nil
+}
+
+// --------------------------------
+//  Encoding
+// --------------------------------
+
+func toBytes(v interface{}) ([]byte, error) {
+	switch x := v.(type) {
+	case []byte:
+		return x, nil
+	case koinos.VariableBlob:
+		return []byte(x), nil
+	case *koinos.VariableBlob:
+		return []byte(*x), nil
+	case string:
+		return koinos.DecodeBytes(x)
+	default:
+		return nil, fmt.Errorf("Expected bytes, got %T", v)
+	}
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Expected an object, got %T", v)
+	}
+	return m, nil
+}
+
+func (s *Schema) encode(t *typeRef, v interface{}, vb *koinos.VariableBlob) (*koinos.VariableBlob, error) {
+	name := strings.Join(t.Name, "::")
+
+	if info, ok := intTypes[name]; ok {
+		return encodeInt(info, v, vb)
+	}
+
+	switch name {
+	case "koinos::boolean":
+		b, ok := v.(bool)
+		if !ok {
+			return vb, fmt.Errorf("Expected a boolean, got %T", v)
+		}
+		kb := koinos.Boolean(b)
+		return kb.Serialize(vb), nil
+
+	case "std::string":
+		str, ok := v.(string)
+		if !ok {
+			return vb, fmt.Errorf("Expected a string, got %T", v)
+		}
+		if !utf8.ValidString(str) {
+			return vb, errors.New("String is not UTF-8 encoded")
+		}
+		ks := koinos.String(str)
+		return ks.Serialize(vb), nil
+
+	case "koinos::variable_blob":
+		b, err := toBytes(v)
+		if err != nil {
+			return vb, err
+		}
+		kb := koinos.VariableBlob(b)
+		return kb.Serialize(vb), nil
+
+	case "koinos::fixed_blob":
+		arg, err := t.targ(0)
+		if err != nil {
+			return vb, err
+		}
+		b, err := toBytes(v)
+		if err != nil {
+			return vb, err
+		}
+		if int64(len(b)) != arg.Value {
+			return vb, fmt.Errorf("Fixed blob length %d does not match size %d", len(b), arg.Value)
+		}
+		ovb := append(*vb, b...)
+		return &ovb, nil
+
+	case "koinos::multihash":
+		return encodeMultihash(v, vb)
+
+	case "std::vector":
+		arg, err := t.targ(0)
+		if err != nil {
+			return vb, err
+		}
+		items, ok := v.([]interface{})
+		if !ok {
+			return vb, fmt.Errorf("Expected an array, got %T", v)
+		}
+		vb = koinos.EncodeVarint(vb, uint64(len(items)))
+		for _, item := range items {
+			if vb, err = s.encode(arg, item, vb); err != nil {
+				return vb, err
+			}
+		}
+		return vb, nil
+
+	case "std::optional":
+		arg, err := t.targ(0)
+		if err != nil {
+			return vb, err
+		}
+		if v == nil {
+			ovb := append(*vb, 0)
+			return &ovb, nil
+		}
+		ovb := append(*vb, 1)
+		return s.encode(arg, v, &ovb)
+
+	case "std::variant":
+		return s.encodeVariant(t, v, vb)
+
+	case "koinos::opaque":
+		return s.encodeOpaque(t, v, vb)
+	}
+
+	d, ok := s.decls[name]
+	if !ok {
+		return vb, errors.New("Unknown type: " + name)
+	}
+
+	switch d.Info.Type {
+	case "Struct":
+		m, err := toMap(v)
+		if err != nil {
+			return vb, err
+		}
+		if err = checkFields(d, name, m); err != nil {
+			return vb, err
+		}
+		for i := range d.Fields {
+			f := &d.Fields[i]
+			fv, ok := m[f.Name]
+			if !ok {
+				return vb, errors.New("Missing field " + f.Name + " in " + name)
+			}
+			if vb, err = s.encode(&f.Tref, fv, vb); err != nil {
+				return vb, err
+			}
+		}
+		return vb, nil
+
+	case "Typedef":
+		return s.encode(d.Tref, v, vb)
+
+	case "EnumClass":
+		if str, ok := v.(string); ok {
+			for _, e := range d.Entries {
+				if e.Name == str {
+					return s.encode(d.Tref, e.Value, vb)
+				}
+			}
+		}
+		n, err := toBigInt(v)
+		if err != nil {
+			return vb, err
+		}
+		for _, e := range d.Entries {
+			if n.IsInt64() && n.Int64() == e.Value {
+				return s.encode(d.Tref, n, vb)
+			}
+		}
+		return vb, fmt.Errorf("Invalid %s: %v", name, v)
+	}
+
+	return vb, errors.New("Unsupported type: " + name)
+}
+
+// checkFields rejects the keys of m that are not fields of the struct d
+func checkFields(d *decl, name string, m map[string]interface{}) error {
+	if len(m) <= len(d.Fields) {
+		return nil
+	}
+
+	fields := make(map[string]bool, len(d.Fields))
+	for _, f := range d.Fields {
+		fields[f.Name] = true
+	}
+	var unknown []string
+	for k := range m {
+		if !fields[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+	return errors.New("Unknown field " + strings.Join(unknown, ", ") + " in " + name)
+}
+
+func encodeMultihash(v interface{}, vb *koinos.VariableBlob) (*koinos.VariableBlob, error) {
+	if str, ok := v.(string); ok {
+		b, err := koinos.DecodeBytes(str)
+		if err != nil {
+			return vb, err
+		}
+		mvb := koinos.VariableBlob(b)
+		n, mh, err := koinos.DeserializeMultihash(&mvb)
+		if err != nil {
+			return vb, err
+		}
+		if n != uint64(len(b)) {
+			return vb, errors.New("Multihash had extra bytes")
+		}
+		return mh.Serialize(vb), nil
+	}
+
+	m, err := toMap(v)
+	if err != nil {
+		return vb, err
+	}
+
+	id, err := toBigInt(m["id"])
+	if err != nil {
+		return vb, err
+	}
+	if !id.IsUint64() {
+		return vb, errors.New("Multihash id is out of bounds")
+	}
+
+	digest, err := toBytes(m["digest"])
+	if err != nil {
+		return vb, err
+	}
+
+	mh := koinos.Multihash{ID: koinos.UInt64(id.Uint64()), Digest: koinos.VariableBlob(digest)}
+	return mh.Serialize(vb), nil
+}
+
+func (s *Schema) encodeVariant(t *typeRef, v interface{}, vb *koinos.VariableBlob) (*koinos.VariableBlob, error) {
+	m, err := toMap(v)
+	if err != nil {
+		return vb, err
+	}
+
+	tag, ok := m["type"].(string)
+	if !ok {
+		return vb, errors.New("Variant is missing its type")
+	}
+
+	for i := range t.Targs {
+		if typeName(&t.Targs[i]) == tag {
+			vb = koinos.EncodeVarint(vb, uint64(i))
+			value, ok := m["value"]
+			if !ok {
+				return vb, errors.New("Variant is missing its value")
+			}
+			return s.encode(&t.Targs[i], value, vb)
+		}
+	}
+
+	return vb, errors.New("Unknown variant type: " + tag)
+}
+
+func (s *Schema) encodeOpaque(t *typeRef, v interface{}, vb *koinos.VariableBlob) (*koinos.VariableBlob, error) {
+	arg, err := t.targ(0)
+	if err != nil {
+		return vb, err
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		if o, isOpaque := m["opaque"]; isOpaque && len(m) == 1 {
+			om, err := toMap(o)
+			if err != nil {
+				return vb, err
+			}
+			if om["type"] != typeName(arg) {
+				return vb, errors.New("Unexpected opaque type name")
+			}
+			b, err := toBytes(om["value"])
+			if err != nil {
+				return vb, err
+			}
+			kb := koinos.VariableBlob(b)
+			return kb.Serialize(vb), nil
+		}
+	}
+
+	inner, err := s.encode(arg, v, koinos.NewVariableBlob())
+	if err != nil {
+		return vb, err
+	}
+	return inner.Serialize(vb), nil
+}
+
+// --------------------------------
+//  Decoding
+// --------------------------------
+
+func (s *Schema) decode(t *typeRef, data []byte) (uint64, interface{}, error) {
+	name := strings.Join(t.Name, "::")
+
+	if info, ok := intTypes[name]; ok {
+		return decodeInt(info, data)
+	}
+
+	vb := koinos.VariableBlob(data)
+
+	switch name {
+	case "koinos::boolean":
+		n, b, err := koinos.DeserializeBoolean(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		return n, bool(*b), nil
+
+	case "std::string":
+		n, str, err := koinos.DeserializeString(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		return n, string(*str), nil
+
+	case "koinos::variable_blob":
+		n, b, err := koinos.DeserializeVariableBlob(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		return n, []byte(*b), nil
+
+	case "koinos::fixed_blob":
+		arg, err := t.targ(0)
+		if err != nil {
+			return 0, nil, err
+		}
+		if int64(len(data)) < arg.Value {
+			return 0, nil, errors.New("Unexpected EOF")
+		}
+		b := make([]byte, arg.Value)
+		copy(b, data)
+		return uint64(arg.Value), b, nil
+
+	case "koinos::multihash":
+		n, mh, err := koinos.DeserializeMultihash(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		return n, map[string]interface{}{"id": uint64(mh.ID), "digest": []byte(mh.Digest)}, nil
+
+	case "std::vector":
+		arg, err := t.targ(0)
+		if err != nil {
+			return 0, nil, err
+		}
+		size, i := impl.Uvarint(data)
+		if i <= 0 {
+			return 0, nil, errors.New("Could not deserialize vector size")
+		}
+		if size > uint64(len(data)) {
+			return 0, nil, errors.New("Unexpected EOF")
+		}
+		consumed := uint64(i)
+		items := make([]interface{}, 0, size)
+		for num := uint64(0); num < size; num++ {
+			j, item, err := s.decode(arg, data[consumed:])
+			if err != nil {
+				return 0, nil, err
+			}
+			consumed += j
+			items = append(items, item)
+		}
+		return consumed, items, nil
+
+	case "std::optional":
+		arg, err := t.targ(0)
+		if err != nil {
+			return 0, nil, err
+		}
+		_, b, err := koinos.DeserializeBoolean(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		if !*b {
+			return 1, nil, nil
+		}
+		j, item, err := s.decode(arg, data[1:])
+		if err != nil {
+			return 0, nil, err
+		}
+		return j + 1, item, nil
+
+	case "std::variant":
+		tag, i := impl.Uvarint(data)
+		if i <= 0 {
+			return 0, nil, errors.New("Could not deserialize variant tag")
+		}
+		if tag >= uint64(len(t.Targs)) {
+			return 0, nil, errors.New("Unknown variant tag")
+		}
+		j, value, err := s.decode(&t.Targs[tag], data[i:])
+		if err != nil {
+			return 0, nil, err
+		}
+		return uint64(i) + j, map[string]interface{}{"type": typeName(&t.Targs[tag]), "value": value}, nil
+
+	case "koinos::opaque":
+		arg, err := t.targ(0)
+		if err != nil {
+			return 0, nil, err
+		}
+		n, blob, err := koinos.DeserializeVariableBlob(&vb)
+		if err != nil {
+			return 0, nil, err
+		}
+		j, value, err := s.decode(arg, *blob)
+		if err == nil && j == uint64(len(*blob)) {
+			return n, value, nil
+		}
+		opaque := map[string]interface{}{"type": typeName(arg), "value": []byte(*blob)}
+		return n, map[string]interface{}{"opaque": opaque}, nil
+	}
+
+	d, ok := s.decls[name]
+	if !ok {
+		return 0, nil, errors.New("Unknown type: " + name)
+	}
+
+	switch d.Info.Type {
+	case "Struct":
+		m := make(map[string]interface{}, len(d.Fields))
+		consumed := uint64(0)
+		for i := range d.Fields {
+			f := &d.Fields[i]
+			j, value, err := s.decode(&f.Tref, data[consumed:])
+			if err != nil {
+				return 0, nil, err
+			}
+			consumed += j
+			m[f.Name] = value
+		}
+		return consumed, m, nil
+
+	case "Typedef":
+		return s.decode(d.Tref, data)
+
+	case "EnumClass":
+		j, value, err := s.decode(d.Tref, data)
+		if err != nil {
+			return 0, nil, err
+		}
+		n, err := toBigInt(value)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, e := range d.Entries {
+			if n.IsInt64() && n.Int64() == e.Value {
+				return j, value, nil
+			}
+		}
+		return 0, nil, fmt.Errorf("Invalid %s: %v", name, value)
+	}
+
+	return 0, nil, errors.New("Unsupported type: " + name)
+}
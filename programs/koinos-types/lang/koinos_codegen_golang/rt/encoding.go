@@ -0,0 +1,12 @@
+package koinos
+
+import (
+	"errors"
+)
+
+// --------------------------------
+//  encoding Interfaces
+// --------------------------------
+
+// ErrTrailingBytes is returned when deserialization does not consume all of its input
+var ErrTrailingBytes = errors.New("Deserialization did not consume all bytes")
@@ -0,0 +1,26 @@
+package koinos
+
+import (
+	"sync/atomic"
+)
+
+// --------------------------------
+//  Enums
+// --------------------------------
+
+var jsonEnumNames uint32
+
+// SetJSONEnumNames selects whether MarshalJSON and MarshalText write enums as the names of their IDL constants.
+// The default writes numbers, like the C++ JSON encoding. Decoding accepts names and numbers regardless of this setting.
+func SetJSONEnumNames(names bool) {
+	var v uint32
+	if names {
+		v = 1
+	}
+	atomic.StoreUint32(&jsonEnumNames, v)
+}
+
+// JSONEnumNames reports whether MarshalJSON and MarshalText write enums as names
+func JSONEnumNames() bool {
+	return atomic.LoadUint32(&jsonEnumNames) != 0
+}
@@ -0,0 +1,239 @@
+package koinos
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// --------------------------------
+//  ForkTree
+// --------------------------------
+
+type forkNode struct {
+	topology BlockTopology
+	parent   *forkNode
+	sequence uint64
+}
+
+// ForkTree tracks the block topology above the last irreversible block
+type ForkTree struct {
+	mutex    sync.RWMutex
+	nodes    map[string]*forkNode
+	heads    map[string]*forkNode
+	lib      *forkNode
+	sequence uint64
+}
+
+func multihashKey(m *Multihash) string {
+	vb := NewVariableBlob()
+	vb = m.Serialize(vb)
+	return string(*vb)
+}
+
+// NewForkTree factory, rooted at the last irreversible block
+func NewForkTree(lib BlockTopology) *ForkTree {
+	root := &forkNode{topology: lib}
+	key := multihashKey(&lib.ID)
+
+	t := ForkTree{}
+	t.nodes = map[string]*forkNode{key: root}
+	t.heads = map[string]*forkNode{key: root}
+	t.lib = root
+	return &t
+}
+
+// Add inserts a block into the tree.
+// Adding a block that is already known is a no-op.
+func (t *ForkTree) Add(topology BlockTopology) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := multihashKey(&topology.ID)
+	if _, ok := t.nodes[key]; ok {
+		return nil
+	}
+
+	if topology.Height <= t.lib.topology.Height {
+		return errors.New("Block is at or below the last irreversible block")
+	}
+
+	parentKey := multihashKey(&topology.Previous)
+	parent, ok := t.nodes[parentKey]
+	if !ok {
+		return errors.New("Unknown previous block")
+	}
+
+	if topology.Height != parent.topology.Height+1 {
+		return errors.New("Block height does not follow previous block")
+	}
+
+	t.sequence++
+	node := &forkNode{topology: topology, parent: parent, sequence: t.sequence}
+	t.nodes[key] = node
+	delete(t.heads, parentKey)
+	t.heads[key] = node
+
+	return nil
+}
+
+// SetLastIrreversible advances the last irreversible block, pruning every block
+// that does not descend from it
+func (t *ForkTree) SetLastIrreversible(id Multihash) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	lib, ok := t.nodes[multihashKey(&id)]
+	if !ok {
+		return errors.New("Unknown block")
+	}
+
+	if lib.topology.Height < t.lib.topology.Height {
+		return errors.New("Last irreversible block cannot move backwards")
+	}
+
+	nodes := make(map[string]*forkNode)
+	heads := make(map[string]*forkNode)
+	for key, node := range t.nodes {
+		if node == lib || isDescendant(node, lib) {
+			nodes[key] = node
+			if _, isHead := t.heads[key]; isHead {
+				heads[key] = node
+			}
+		}
+	}
+
+	lib.parent = nil
+	t.nodes = nodes
+	t.heads = heads
+	t.lib = lib
+	return nil
+}
+
+func isDescendant(node *forkNode, ancestor *forkNode) bool {
+	for n := node.parent; n != nil; n = n.parent {
+		if n == ancestor {
+			return true
+		}
+		if n.topology.Height <= ancestor.topology.Height {
+			return false
+		}
+	}
+	return false
+}
+
+// LastIrreversible returns the last irreversible block
+func (t *ForkTree) LastIrreversible() BlockTopology {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.lib.topology
+}
+
+// Contains reports whether a block is known to the tree
+func (t *ForkTree) Contains(id Multihash) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	_, ok := t.nodes[multihashKey(&id)]
+	return ok
+}
+
+// Head returns the highest fork head. Ties are won by the block that was added first.
+func (t *ForkTree) Head() BlockTopology {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var head *forkNode
+	for _, node := range t.heads {
+		if head == nil ||
+			node.topology.Height > head.topology.Height ||
+			(node.topology.Height == head.topology.Height && node.sequence < head.sequence) {
+			head = node
+		}
+	}
+	return head.topology
+}
+
+// Heads returns every fork head, highest first. Heads at the same height are in the order they were
+// added, so the first head is Head.
+func (t *ForkTree) Heads() VectorBlockTopology {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.sortedHeads()
+}
+
+// HeadsAndLastIrreversible returns Heads and LastIrreversible as of the same moment
+func (t *ForkTree) HeadsAndLastIrreversible() (VectorBlockTopology, BlockTopology) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.sortedHeads(), t.lib.topology
+}
+
+func (t *ForkTree) sortedHeads() VectorBlockTopology {
+	heads := make([]*forkNode, 0, len(t.heads))
+	for _, node := range t.heads {
+		heads = append(heads, node)
+	}
+	sort.Slice(heads, func(i, j int) bool {
+		if heads[i].topology.Height != heads[j].topology.Height {
+			return heads[i].topology.Height > heads[j].topology.Height
+		}
+		return heads[i].sequence < heads[j].sequence
+	})
+
+	result := VectorBlockTopology(make([]BlockTopology, 0, len(heads)))
+	for _, node := range heads {
+		result = append(result, node.topology)
+	}
+	return result
+}
+
+// AncestorAt returns the ancestor of a block at the given height
+func (t *ForkTree) AncestorAt(id Multihash, height BlockHeightType) (*BlockTopology, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	node, ok := t.nodes[multihashKey(&id)]
+	if !ok {
+		return nil, errors.New("Unknown block")
+	}
+
+	if height > node.topology.Height || height < t.lib.topology.Height {
+		return nil, errors.New("Height is out of range")
+	}
+
+	for node.topology.Height > height {
+		node = node.parent
+	}
+
+	o := node.topology
+	return &o, nil
+}
+
+// CommonAncestor returns the most recent block that both blocks descend from
+func (t *ForkTree) CommonAncestor(a Multihash, b Multihash) (*BlockTopology, error) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	x, ok := t.nodes[multihashKey(&a)]
+	if !ok {
+		return nil, errors.New("Unknown block")
+	}
+	y, ok := t.nodes[multihashKey(&b)]
+	if !ok {
+		return nil, errors.New("Unknown block")
+	}
+
+	for x.topology.Height > y.topology.Height {
+		x = x.parent
+	}
+	for y.topology.Height > x.topology.Height {
+		y = y.parent
+	}
+	for x != y {
+		x = x.parent
+		y = y.parent
+	}
+
+	o := x.topology
+	return &o, nil
+}
@@ -0,0 +1,257 @@
+package koinos
+
+import (
+	"math/rand"
+	"reflect"
+	"unicode/utf8"
+)
+
+// --------------------------------
+//  Generate
+// --------------------------------
+
+// The GenerateX functions return a random X for property tests, size bounds the length of variable length
+// values. The Generate methods implement quick.Generator, so testing/quick can produce any type.
+
+// GenerateString function, a valid UTF-8 string
+func GenerateString(r *rand.Rand, size int) *String {
+	runes := make([]rune, r.Intn(size+1))
+	for i := range runes {
+		c := rune(0x20 + r.Intn(0x5F))
+		if r.Intn(2) == 0 {
+			c = rune(r.Intn(utf8.MaxRune + 1))
+		}
+		if !utf8.ValidRune(c) {
+			c = utf8.RuneError
+		}
+		runes[i] = c
+	}
+	o := String(runes)
+	return &o
+}
+
+// GenerateBoolean function
+func GenerateBoolean(r *rand.Rand, size int) *Boolean {
+	o := Boolean(r.Intn(2) == 1)
+	return &o
+}
+
+// GenerateInt8 function
+func GenerateInt8(r *rand.Rand, size int) *Int8 {
+	o := Int8(r.Uint64())
+	return &o
+}
+
+// GenerateUInt8 function
+func GenerateUInt8(r *rand.Rand, size int) *UInt8 {
+	o := UInt8(r.Uint64())
+	return &o
+}
+
+// GenerateInt16 function
+func GenerateInt16(r *rand.Rand, size int) *Int16 {
+	o := Int16(r.Uint64())
+	return &o
+}
+
+// GenerateUInt16 function
+func GenerateUInt16(r *rand.Rand, size int) *UInt16 {
+	o := UInt16(r.Uint64())
+	return &o
+}
+
+// GenerateInt32 function
+func GenerateInt32(r *rand.Rand, size int) *Int32 {
+	o := Int32(r.Uint64())
+	return &o
+}
+
+// GenerateUInt32 function
+func GenerateUInt32(r *rand.Rand, size int) *UInt32 {
+	o := UInt32(r.Uint64())
+	return &o
+}
+
+// GenerateInt64 function
+func GenerateInt64(r *rand.Rand, size int) *Int64 {
+	o := Int64(r.Uint64())
+	return &o
+}
+
+// GenerateUInt64 function
+func GenerateUInt64(r *rand.Rand, size int) *UInt64 {
+	o := UInt64(r.Uint64())
+	return &o
+}
+
+// GenerateInt128 function
+func GenerateInt128(r *rand.Rand, size int) *Int128 {
+	vb := make(VariableBlob, 16)
+	r.Read(vb)
+	_, o, _ := DeserializeInt128(&vb)
+	return o
+}
+
+// GenerateUInt128 function
+func GenerateUInt128(r *rand.Rand, size int) *UInt128 {
+	vb := make(VariableBlob, 16)
+	r.Read(vb)
+	_, o, _ := DeserializeUInt128(&vb)
+	return o
+}
+
+// GenerateInt160 function
+func GenerateInt160(r *rand.Rand, size int) *Int160 {
+	vb := make(VariableBlob, 20)
+	r.Read(vb)
+	_, o, _ := DeserializeInt160(&vb)
+	return o
+}
+
+// GenerateUInt160 function
+func GenerateUInt160(r *rand.Rand, size int) *UInt160 {
+	vb := make(VariableBlob, 20)
+	r.Read(vb)
+	_, o, _ := DeserializeUInt160(&vb)
+	return o
+}
+
+// GenerateInt256 function
+func GenerateInt256(r *rand.Rand, size int) *Int256 {
+	vb := make(VariableBlob, 32)
+	r.Read(vb)
+	_, o, _ := DeserializeInt256(&vb)
+	return o
+}
+
+// GenerateUInt256 function
+func GenerateUInt256(r *rand.Rand, size int) *UInt256 {
+	vb := make(VariableBlob, 32)
+	r.Read(vb)
+	_, o, _ := DeserializeUInt256(&vb)
+	return o
+}
+
+// GenerateVariableBlob function
+func GenerateVariableBlob(r *rand.Rand, size int) *VariableBlob {
+	o := make(VariableBlob, r.Intn(size+1))
+	r.Read(o)
+	return &o
+}
+
+// GenerateTimestampType function
+func GenerateTimestampType(r *rand.Rand, size int) *TimestampType {
+	o := TimestampType(r.Uint64())
+	return &o
+}
+
+// GenerateBlockHeightType function
+func GenerateBlockHeightType(r *rand.Rand, size int) *BlockHeightType {
+	o := BlockHeightType(r.Uint64())
+	return &o
+}
+
+// GenerateMultihash function
+func GenerateMultihash(r *rand.Rand, size int) *Multihash {
+	return &Multihash{ID: UInt64(r.Uint64()), Digest: *GenerateVariableBlob(r, size)}
+}
+
+// Generate String, implementing quick.Generator
+func (n String) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateString(r, size))
+}
+
+// Generate Boolean, implementing quick.Generator
+func (n Boolean) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateBoolean(r, size))
+}
+
+// Generate Int8, implementing quick.Generator
+func (n Int8) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt8(r, size))
+}
+
+// Generate UInt8, implementing quick.Generator
+func (n UInt8) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt8(r, size))
+}
+
+// Generate Int16, implementing quick.Generator
+func (n Int16) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt16(r, size))
+}
+
+// Generate UInt16, implementing quick.Generator
+func (n UInt16) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt16(r, size))
+}
+
+// Generate Int32, implementing quick.Generator
+func (n Int32) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt32(r, size))
+}
+
+// Generate UInt32, implementing quick.Generator
+func (n UInt32) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt32(r, size))
+}
+
+// Generate Int64, implementing quick.Generator
+func (n Int64) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt64(r, size))
+}
+
+// Generate UInt64, implementing quick.Generator
+func (n UInt64) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt64(r, size))
+}
+
+// Generate Int128, implementing quick.Generator
+func (n Int128) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt128(r, size))
+}
+
+// Generate UInt128, implementing quick.Generator
+func (n UInt128) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt128(r, size))
+}
+
+// Generate Int160, implementing quick.Generator
+func (n Int160) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt160(r, size))
+}
+
+// Generate UInt160, implementing quick.Generator
+func (n UInt160) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt160(r, size))
+}
+
+// Generate Int256, implementing quick.Generator
+func (n Int256) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateInt256(r, size))
+}
+
+// Generate UInt256, implementing quick.Generator
+func (n UInt256) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateUInt256(r, size))
+}
+
+// Generate VariableBlob, implementing quick.Generator
+func (n VariableBlob) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateVariableBlob(r, size))
+}
+
+// Generate TimestampType, implementing quick.Generator
+func (n TimestampType) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateTimestampType(r, size))
+}
+
+// Generate BlockHeightType, implementing quick.Generator
+func (n BlockHeightType) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateBlockHeightType(r, size))
+}
+
+// Generate Multihash, implementing quick.Generator
+func (n Multihash) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(*GenerateMultihash(r, size))
+}
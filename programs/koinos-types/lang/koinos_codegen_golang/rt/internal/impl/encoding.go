@@ -0,0 +1,62 @@
+// Package impl holds the helpers the generated packages share. It is not part of the public API.
+package impl
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// --------------------------------
+//  Encoding Helpers
+// --------------------------------
+
+// BinaryAppender is implemented by every generated type
+type BinaryAppender interface {
+	AppendBinary(dst []byte) []byte
+	SerializedSize() int
+}
+
+// AppendUvarint appends the varint encoding of value to dst
+func AppendUvarint(dst []byte, value uint64) []byte {
+	for value >= 0x80 {
+		dst = append(dst, byte(value)|0x80)
+		value >>= 7
+	}
+	return append(dst, byte(value))
+}
+
+// Uvarint decodes a varint from the front of buf like binary.Uvarint, and also rejects encodings longer than the
+// shortest one, so a decoded value always serializes to the bytes it was decoded from. The count is 0 when buf is
+// too small and negative for an overflowing or overlong encoding.
+func Uvarint(buf []byte) (uint64, int) {
+	x, n := binary.Uvarint(buf)
+	if n > 1 && buf[n-1] == 0 {
+		return 0, -n
+	}
+	return x, n
+}
+
+// UvarintSize returns the number of bytes in the varint encoding of x
+func UvarintSize(x uint64) int {
+	n := 1
+	for x >= 0x80 {
+		x >>= 7
+		n++
+	}
+	return n
+}
+
+// MarshalBinary returns the canonical encoding of v, reporting an invalid value as an error instead of a panic
+func MarshalBinary(v BinaryAppender) (b []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, ok := r.(string)
+			if !ok {
+				panic(r)
+			}
+			b, err = nil, errors.New(msg)
+		}
+	}()
+
+	return v.AppendBinary(make([]byte, 0, v.SerializedSize())), nil
+}
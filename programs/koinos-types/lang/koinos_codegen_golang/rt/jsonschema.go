@@ -0,0 +1,74 @@
+package koinos
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// --------------------------------
+//  JSON Schema
+// --------------------------------
+
+type jsonSchemaBundle struct {
+	Schema string                     `json:"$schema"`
+	Defs   map[string]json.RawMessage `json:"$defs"`
+}
+
+var (
+	jsonSchemaDefs *jsonSchemaBundle
+	jsonSchemaErr  error
+	jsonSchemaOnce sync.Once
+)
+
+func loadJSONSchema() (*jsonSchemaBundle, error) {
+	jsonSchemaOnce.Do(func() {
+		var b jsonSchemaBundle
+		jsonSchemaErr = json.Unmarshal([]byte(jsonSchemaBundleJSON), &b)
+		jsonSchemaDefs = &b
+	})
+	return jsonSchemaDefs, jsonSchemaErr
+}
+
+// JSONSchemaBundle returns a JSON Schema document whose $defs describe every type, keyed by fully qualified IDL name
+func JSONSchemaBundle() []byte {
+	return []byte(jsonSchemaBundleJSON)
+}
+
+// JSONSchema returns a standalone JSON Schema document describing the JSON encoding of a type
+func JSONSchema(name string) ([]byte, error) {
+	bundle, err := loadJSONSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	def, ok := bundle.Defs[name]
+	if !ok {
+		return nil, errors.New("Unknown type: " + name)
+	}
+
+	doc := make(map[string]interface{})
+	if err = json.Unmarshal(def, &doc); err != nil {
+		return nil, err
+	}
+	doc["$schema"] = bundle.Schema
+	doc["$defs"] = bundle.Defs
+
+	return json.Marshal(doc)
+}
+
+// JSONSchemaNames returns the name of every type with a JSON Schema definition
+func JSONSchemaNames() []string {
+	bundle, err := loadJSONSchema()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(bundle.Defs))
+	for name := range bundle.Defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
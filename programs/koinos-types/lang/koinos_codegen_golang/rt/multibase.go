@@ -0,0 +1,303 @@
+package koinos
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// --------------------------------
+//  Multibase
+// --------------------------------
+
+// Multibase is the prefix character identifying a multibase encoding
+type Multibase byte
+
+// Supported multibase encodings
+const (
+	MultibaseBase2             Multibase = '0'
+	MultibaseBase8             Multibase = '7'
+	MultibaseBase10            Multibase = '9'
+	MultibaseBase16            Multibase = 'f'
+	MultibaseBase16Upper       Multibase = 'F'
+	MultibaseBase32            Multibase = 'b'
+	MultibaseBase32Upper       Multibase = 'B'
+	MultibaseBase32Pad         Multibase = 'c'
+	MultibaseBase32PadUpper    Multibase = 'C'
+	MultibaseBase32Hex         Multibase = 'v'
+	MultibaseBase32HexUpper    Multibase = 'V'
+	MultibaseBase32HexPad      Multibase = 't'
+	MultibaseBase32HexPadUpper Multibase = 'T'
+	MultibaseBase32Z           Multibase = 'h'
+	MultibaseBase36            Multibase = 'k'
+	MultibaseBase36Upper       Multibase = 'K'
+	MultibaseBase58BTC         Multibase = 'z'
+	MultibaseBase58Flickr      Multibase = 'Z'
+	MultibaseBase64            Multibase = 'm'
+	MultibaseBase64Pad         Multibase = 'M'
+	MultibaseBase64URL         Multibase = 'u'
+	MultibaseBase64URLPad      Multibase = 'U'
+)
+
+const (
+	base58Alphabet       = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base58FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	base36Alphabet       = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base10Alphabet       = "0123456789"
+	base32ZAlphabet      = "ybndrfg8ejkmcpqxot1uwisza345h769"
+)
+
+var jsonMultibase = uint32(MultibaseBase58BTC)
+
+// SetJSONMultibase selects the encoding MarshalJSON and MarshalText use for blobs and multihashes.
+// The default is MultibaseBase58BTC. Decoding accepts every supported encoding regardless of this setting.
+func SetJSONMultibase(m Multibase) error {
+	if _, err := EncodeMultibase(nil, m); err != nil {
+		return err
+	}
+	atomic.StoreUint32(&jsonMultibase, uint32(m))
+	return nil
+}
+
+// JSONMultibase returns the encoding MarshalJSON and MarshalText use for blobs and multihashes
+func JSONMultibase() Multibase {
+	return Multibase(atomic.LoadUint32(&jsonMultibase))
+}
+
+// encodeJSONBytes encodes b with the configured JSON multibase encoding
+func encodeJSONBytes(b []byte) string {
+	s, _ := EncodeMultibase(b, JSONMultibase())
+	return s
+}
+
+var base32ZEncoding = base32.NewEncoding(strings.ToUpper(base32ZAlphabet)).WithPadding(base32.NoPadding)
+
+// base32Encoding returns the encoding of a base32 multibase. The encodings use upper case letters.
+func base32Encoding(m Multibase) *base32.Encoding {
+	switch m {
+	case MultibaseBase32, MultibaseBase32Upper:
+		return base32.StdEncoding.WithPadding(base32.NoPadding)
+	case MultibaseBase32Hex, MultibaseBase32HexUpper:
+		return base32.HexEncoding.WithPadding(base32.NoPadding)
+	case MultibaseBase32HexPad, MultibaseBase32HexPadUpper:
+		return base32.HexEncoding
+	case MultibaseBase32Z:
+		return base32ZEncoding
+	default:
+		return base32.StdEncoding
+	}
+}
+
+func base64Encoding(m Multibase) *base64.Encoding {
+	switch m {
+	case MultibaseBase64:
+		return base64.RawStdEncoding
+	case MultibaseBase64Pad:
+		return base64.StdEncoding
+	case MultibaseBase64URL:
+		return base64.RawURLEncoding
+	default:
+		return base64.URLEncoding
+	}
+}
+
+// encodeBits writes b most significant bit first, bits at a time, zero filling the last digit
+func encodeBits(b []byte, bits uint, alphabet string) string {
+	var sb strings.Builder
+	var acc, n uint
+	for _, c := range b {
+		acc = acc<<8 | uint(c)
+		n += 8
+		for n >= bits {
+			n -= bits
+			sb.WriteByte(alphabet[acc>>n])
+			acc &= 1<<n - 1
+		}
+	}
+	if n > 0 {
+		sb.WriteByte(alphabet[acc<<(bits-n)])
+	}
+	return sb.String()
+}
+
+// decodeBits reverses encodeBits. The bits filling the last digit must be zero.
+func decodeBits(s string, bits uint, alphabet string) ([]byte, bool) {
+	b := make([]byte, 0, len(s)*int(bits)/8)
+	var acc, n uint
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(alphabet, s[i])
+		if d < 0 {
+			return nil, false
+		}
+		acc = acc<<bits | uint(d)
+		n += bits
+		if n >= 8 {
+			n -= 8
+			b = append(b, byte(acc>>n))
+			acc &= 1<<n - 1
+		}
+	}
+	return b, n < bits && acc == 0
+}
+
+// encodeNumber writes b as a number in the base of alphabet, each leading zero byte as a leading zero digit
+func encodeNumber(b []byte, alphabet string) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	var digits []byte
+	n := new(big.Int).SetBytes(b[zeros:])
+	base := big.NewInt(int64(len(alphabet)))
+	d := new(big.Int)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, d)
+		digits = append(digits, alphabet[d.Int64()])
+	}
+	for i := 0; i < zeros; i++ {
+		digits = append(digits, alphabet[0])
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}
+
+// decodeNumber reverses encodeNumber
+func decodeNumber(s string, alphabet string) ([]byte, bool) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(int64(len(alphabet)))
+	for i := zeros; i < len(s); i++ {
+		d := strings.IndexByte(alphabet, s[i])
+		if d < 0 {
+			return nil, false
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(d)))
+	}
+	return append(make([]byte, zeros), n.Bytes()...), true
+}
+
+// EncodeMultibase encodes b as a multibase string with the given encoding
+func EncodeMultibase(b []byte, m Multibase) (string, error) {
+	var s string
+	switch m {
+	case MultibaseBase2:
+		s = encodeBits(b, 1, "01")
+	case MultibaseBase8:
+		s = encodeBits(b, 3, "01234567")
+	case MultibaseBase10:
+		s = encodeNumber(b, base10Alphabet)
+	case MultibaseBase36:
+		s = encodeNumber(b, base36Alphabet)
+	case MultibaseBase36Upper:
+		s = strings.ToUpper(encodeNumber(b, base36Alphabet))
+	case MultibaseBase58BTC:
+		s = base58.Encode(b)
+	case MultibaseBase58Flickr:
+		s = encodeNumber(b, base58FlickrAlphabet)
+	case MultibaseBase16:
+		s = hex.EncodeToString(b)
+	case MultibaseBase16Upper:
+		s = strings.ToUpper(hex.EncodeToString(b))
+	case MultibaseBase32, MultibaseBase32Pad, MultibaseBase32Hex, MultibaseBase32HexPad, MultibaseBase32Z:
+		s = strings.ToLower(base32Encoding(m).EncodeToString(b))
+	case MultibaseBase32Upper, MultibaseBase32PadUpper, MultibaseBase32HexUpper, MultibaseBase32HexPadUpper:
+		s = base32Encoding(m).EncodeToString(b)
+	case MultibaseBase64, MultibaseBase64Pad, MultibaseBase64URL, MultibaseBase64URLPad:
+		s = base64Encoding(m).EncodeToString(b)
+	default:
+		return "", errors.New("Unknown encoding: " + string(m))
+	}
+
+	return string(m) + s, nil
+}
+
+// EncodeBytes utility function, encodes b as base58btc
+func EncodeBytes(b []byte) string {
+	return "z" + base58.Encode(b)
+}
+
+// DecodeBytes utility function, decodes a string in any supported multibase encoding. These are the
+// final encodings of the multibase table except identity and base256emoji, whose strings are not text
+// or do not start with a single byte prefix. Letters are accepted in either case in the encodings that
+// have upper and lower case forms.
+func DecodeBytes(s string) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, errors.New("Missing multibase prefix")
+	}
+
+	m, data := Multibase(s[0]), s[1:]
+	switch m {
+	case MultibaseBase2:
+		b, ok := decodeBits(data, 1, "01")
+		if !ok {
+			return nil, errors.New("Unable to decode base2")
+		}
+		return b, nil
+	case MultibaseBase8:
+		b, ok := decodeBits(data, 3, "01234567")
+		if !ok {
+			return nil, errors.New("Unable to decode base8")
+		}
+		return b, nil
+	case MultibaseBase10:
+		b, ok := decodeNumber(data, base10Alphabet)
+		if !ok {
+			return nil, errors.New("Unable to decode base10")
+		}
+		return b, nil
+	case MultibaseBase36, MultibaseBase36Upper:
+		b, ok := decodeNumber(strings.ToLower(data), base36Alphabet)
+		if !ok {
+			return nil, errors.New("Unable to decode base36")
+		}
+		return b, nil
+	case MultibaseBase58Flickr:
+		b, ok := decodeNumber(data, base58FlickrAlphabet)
+		if !ok {
+			return nil, errors.New("Unable to decode base58")
+		}
+		return b, nil
+	case MultibaseBase58BTC:
+		for i := 0; i < len(data); i++ {
+			if strings.IndexByte(base58Alphabet, data[i]) < 0 {
+				return nil, errors.New("Unable to decode base58")
+			}
+		}
+		return base58.Decode(data), nil
+	case MultibaseBase16, MultibaseBase16Upper:
+		b, err := hex.DecodeString(data)
+		if err != nil {
+			return nil, errors.New("Unable to decode base16")
+		}
+		return b, nil
+	case MultibaseBase32, MultibaseBase32Upper, MultibaseBase32Pad, MultibaseBase32PadUpper,
+		MultibaseBase32Hex, MultibaseBase32HexUpper, MultibaseBase32HexPad, MultibaseBase32HexPadUpper, MultibaseBase32Z:
+		b, err := base32Encoding(m).DecodeString(strings.ToUpper(data))
+		if err != nil {
+			return nil, errors.New("Unable to decode base32")
+		}
+		return b, nil
+	case MultibaseBase64, MultibaseBase64Pad, MultibaseBase64URL, MultibaseBase64URLPad:
+		b, err := base64Encoding(m).DecodeString(data)
+		if err != nil {
+			return nil, errors.New("Unable to decode base64")
+		}
+		return b, nil
+	default:
+		return nil, errors.New("Unknown encoding: " + string(m))
+	}
+}
@@ -0,0 +1,283 @@
+package koinos
+
+import (
+	"fmt"
+
+	"github.com/koinos/koinos-types-golang/pretty"
+)
+
+// --------------------------------
+//  Pretty Printing
+// --------------------------------
+
+// PrettyOptions control the output of PrettyWithOptions
+type PrettyOptions struct {
+	// Indent is written once per nesting level. An empty Indent writes everything on one line.
+	Indent string
+
+	// BlobEncoding is the multibase encoding used for blobs. Zero writes 0x prefixed hex.
+	BlobEncoding Multibase
+}
+
+// Pretty renders v as an indented tree with field names, enum constant names, blob lengths,
+// variant alternative names and the contents of opaque values
+func Pretty(v interface{}) string {
+	return PrettyWithOptions(v, PrettyOptions{Indent: "  "})
+}
+
+// PrettyWithOptions renders v like Pretty using the given options
+func PrettyWithOptions(v interface{}, opts PrettyOptions) string {
+	pp, ok := v.(pretty.Printer)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+
+	p := pretty.NewWriter(opts.Indent, func(b []byte) (string, error) {
+		return EncodeMultibase(b, opts.BlobEncoding)
+	})
+	pp.WritePretty(p)
+	return p.String()
+}
+
+// WritePretty String
+func (n String) WritePretty(p *pretty.Writer) {
+	p.Quote(string(n))
+}
+
+// String String
+func (n String) String() string {
+	return pretty.Compact(n)
+}
+
+// Format String
+func (n String) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, string(n))
+}
+
+// WritePretty Boolean
+func (n Boolean) WritePretty(p *pretty.Writer) {
+	p.Bool(bool(n))
+}
+
+// String Boolean
+func (n Boolean) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Boolean
+func (n Boolean) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, bool(n))
+}
+
+// WritePretty Int8
+func (n Int8) WritePretty(p *pretty.Writer) {
+	p.Int(int64(n))
+}
+
+// String Int8
+func (n Int8) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Int8
+func (n Int8) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, int8(n))
+}
+
+// WritePretty UInt8
+func (n UInt8) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String UInt8
+func (n UInt8) String() string {
+	return pretty.Compact(n)
+}
+
+// Format UInt8
+func (n UInt8) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint8(n))
+}
+
+// WritePretty Int16
+func (n Int16) WritePretty(p *pretty.Writer) {
+	p.Int(int64(n))
+}
+
+// String Int16
+func (n Int16) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Int16
+func (n Int16) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, int16(n))
+}
+
+// WritePretty UInt16
+func (n UInt16) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String UInt16
+func (n UInt16) String() string {
+	return pretty.Compact(n)
+}
+
+// Format UInt16
+func (n UInt16) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint16(n))
+}
+
+// WritePretty Int32
+func (n Int32) WritePretty(p *pretty.Writer) {
+	p.Int(int64(n))
+}
+
+// String Int32
+func (n Int32) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Int32
+func (n Int32) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, int32(n))
+}
+
+// WritePretty UInt32
+func (n UInt32) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String UInt32
+func (n UInt32) String() string {
+	return pretty.Compact(n)
+}
+
+// Format UInt32
+func (n UInt32) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint32(n))
+}
+
+// WritePretty Int64
+func (n Int64) WritePretty(p *pretty.Writer) {
+	p.Int(int64(n))
+}
+
+// String Int64
+func (n Int64) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Int64
+func (n Int64) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, int64(n))
+}
+
+// WritePretty UInt64
+func (n UInt64) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String UInt64
+func (n UInt64) String() string {
+	return pretty.Compact(n)
+}
+
+// Format UInt64
+func (n UInt64) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint64(n))
+}
+
+// WritePretty Int128
+func (n Int128) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty UInt128
+func (n UInt128) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty Int160
+func (n Int160) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty UInt160
+func (n UInt160) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty Int256
+func (n Int256) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty UInt256
+func (n UInt256) WritePretty(p *pretty.Writer) {
+	p.Text(n.String())
+}
+
+// WritePretty VariableBlob
+func (n VariableBlob) WritePretty(p *pretty.Writer) {
+	p.Blob(n)
+}
+
+// String VariableBlob
+func (n VariableBlob) String() string {
+	return pretty.Compact(n)
+}
+
+// Format VariableBlob
+func (n VariableBlob) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, []byte(n))
+}
+
+// WritePretty TimestampType
+func (n TimestampType) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String TimestampType
+func (n TimestampType) String() string {
+	return pretty.Compact(n)
+}
+
+// Format TimestampType
+func (n TimestampType) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint64(n))
+}
+
+// WritePretty BlockHeightType
+func (n BlockHeightType) WritePretty(p *pretty.Writer) {
+	p.Uint(uint64(n))
+}
+
+// String BlockHeightType
+func (n BlockHeightType) String() string {
+	return pretty.Compact(n)
+}
+
+// Format BlockHeightType
+func (n BlockHeightType) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, uint64(n))
+}
+
+// WritePretty Multihash
+func (m0 Multihash) WritePretty(p *pretty.Writer) {
+	p.Open("Multihash", '{')
+	p.Field("ID", m0.ID)
+	p.Field("Digest", m0.Digest)
+	p.Close('}')
+}
+
+// String Multihash
+func (n Multihash) String() string {
+	return pretty.Compact(n)
+}
+
+// Format Multihash
+func (n Multihash) Format(s fmt.State, verb rune) {
+	pretty.Format(s, verb, n, nil)
+}
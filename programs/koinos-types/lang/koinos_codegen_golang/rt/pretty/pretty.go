@@ -0,0 +1,195 @@
+// Package pretty writes the human readable form of the generated types, which implement Printer.
+// Other types implement Printer to nest in the output with the same layout.
+package pretty
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Printer is implemented by every generated type
+type Printer interface {
+	WritePretty(p *Writer)
+}
+
+// Writer accumulates the output of WritePretty methods
+type Writer struct {
+	indent string
+	encode func([]byte) (string, error)
+	buf    []byte
+	count  []int
+}
+
+// NewWriter returns a writer indenting nested values with indent, or writing everything on one line
+// when it is empty. Blobs are written with encode, or as 0x prefixed hex when it is nil or fails.
+func NewWriter(indent string, encode func([]byte) (string, error)) *Writer {
+	return &Writer{indent: indent, encode: encode}
+}
+
+// String returns the output written so far
+func (p *Writer) String() string {
+	return string(p.buf)
+}
+
+// Compact renders v on one line, as the String methods of the generated types do
+func Compact(v Printer) string {
+	p := Writer{}
+	v.WritePretty(&p)
+	return string(p.buf)
+}
+
+// Format implements fmt.Formatter for v, as the Format methods of the generated types do. %v and %s write
+// the one line form, %+v writes the indented tree and %q quotes the one line form. Other verbs format
+// underlying when it is not nil.
+func Format(s fmt.State, verb rune, v Printer, underlying interface{}) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		p := Writer{indent: "  "}
+		v.WritePretty(&p)
+		io.WriteString(s, p.String())
+	case verb == 'v' || verb == 's' || verb == 'q':
+		if verb == 'v' {
+			verb = 's'
+		}
+		fmt.Fprintf(s, formatDirective(s, verb), Compact(v))
+	case underlying != nil:
+		fmt.Fprintf(s, formatDirective(s, verb), underlying)
+	default:
+		fmt.Fprintf(s, "%%!%c(%s)", verb, Compact(v))
+	}
+}
+
+// formatDirective rebuilds the directive that produced s and verb
+func formatDirective(s fmt.State, verb rune) string {
+	d := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if s.Flag(int(flag)) {
+			d = append(d, byte(flag))
+		}
+	}
+	if width, ok := s.Width(); ok {
+		d = strconv.AppendInt(d, int64(width), 10)
+	}
+	if precision, ok := s.Precision(); ok {
+		d = append(d, '.')
+		d = strconv.AppendInt(d, int64(precision), 10)
+	}
+	return string(append(d, string(verb)...))
+}
+
+// Text writes s verbatim
+func (p *Writer) Text(s string) {
+	p.buf = append(p.buf, s...)
+}
+
+// Quote writes s as a double quoted Go string literal
+func (p *Writer) Quote(s string) {
+	p.buf = strconv.AppendQuote(p.buf, s)
+}
+
+// Bool writes b
+func (p *Writer) Bool(b bool) {
+	p.buf = strconv.AppendBool(p.buf, b)
+}
+
+// Int writes i in decimal
+func (p *Writer) Int(i int64) {
+	p.buf = strconv.AppendInt(p.buf, i, 10)
+}
+
+// Uint writes u in decimal
+func (p *Writer) Uint(u uint64) {
+	p.buf = strconv.AppendUint(p.buf, u, 10)
+}
+
+func (p *Writer) newline() {
+	p.buf = append(p.buf, '\n')
+	for range p.count {
+		p.buf = append(p.buf, p.indent...)
+	}
+}
+
+// Open starts a nested value. The name is only written for the outermost value.
+func (p *Writer) Open(name string, delim byte) {
+	if len(p.buf) == 0 {
+		p.buf = append(p.buf, name...)
+	}
+	p.buf = append(p.buf, delim)
+	p.count = append(p.count, 0)
+}
+
+func (p *Writer) next() {
+	n := &p.count[len(p.count)-1]
+	if *n > 0 {
+		p.buf = append(p.buf, ',')
+		if p.indent == "" {
+			p.buf = append(p.buf, ' ')
+		}
+	}
+	if p.indent != "" {
+		p.newline()
+	}
+	*n++
+}
+
+// Close ends the value started by the matching Open
+func (p *Writer) Close(delim byte) {
+	n := p.count[len(p.count)-1]
+	p.count = p.count[:len(p.count)-1]
+	if n > 0 && p.indent != "" {
+		p.buf = append(p.buf, ',')
+		p.newline()
+	}
+	p.buf = append(p.buf, delim)
+}
+
+// Field writes a named struct field
+func (p *Writer) Field(name string, v Printer) {
+	p.next()
+	p.buf = append(p.buf, name...)
+	p.buf = append(p.buf, ':', ' ')
+	v.WritePretty(p)
+}
+
+// Item writes a vector element
+func (p *Writer) Item(v Printer) {
+	p.next()
+	v.WritePretty(p)
+}
+
+// Variant writes the name of the alternative, followed by struct values directly or other values in parentheses
+func (p *Writer) Variant(name string, v Printer, isStruct bool) {
+	p.buf = append(p.buf, name...)
+	if isStruct {
+		v.WritePretty(p)
+		return
+	}
+	p.buf = append(p.buf, '(')
+	v.WritePretty(p)
+	p.buf = append(p.buf, ')')
+}
+
+// Blob writes bytes in the configured encoding followed by their length
+func (p *Writer) Blob(b []byte) {
+	encoded := false
+	if p.encode != nil {
+		if s, err := p.encode(b); err == nil {
+			p.buf = append(p.buf, s...)
+			encoded = true
+		}
+	}
+	if !encoded {
+		p.buf = append(p.buf, '0', 'x')
+		p.buf = append(p.buf, hex.EncodeToString(b)...)
+	}
+
+	p.buf = append(p.buf, " ("...)
+	p.buf = strconv.AppendInt(p.buf, int64(len(b)), 10)
+	if len(b) == 1 {
+		p.buf = append(p.buf, " byte)"...)
+	} else {
+		p.buf = append(p.buf, " bytes)"...)
+	}
+}
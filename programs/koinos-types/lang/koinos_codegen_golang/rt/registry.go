@@ -0,0 +1,144 @@
+package koinos
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// --------------------------------
+//  Type Registry
+// --------------------------------
+
+// TypeKind identifies how a registered type is declared in the IDL
+type TypeKind int
+
+// TypeKind values
+const (
+	KindBase TypeKind = iota
+	KindStruct
+	KindVariant
+	KindTypedef
+	KindEnum
+	KindVector
+	KindOpaque
+	KindFixedBlob
+)
+
+// FieldInfo describes a struct field
+type FieldInfo struct {
+	Name     string
+	GoName   string
+	TypeName string
+}
+
+// TypeInfo describes a registered type.
+// Element is the referenced type of a typedef, enum, vector or opaque.
+// Alternatives lists the types of a variant in tag order.
+// Values lists the IDL names of the values of an enum in declaration order.
+type TypeInfo struct {
+	Name         string
+	GoName       string
+	Kind         TypeKind
+	Fields       []FieldInfo
+	Alternatives []string
+	Values       []string
+	Element      string
+	Size         int
+	New          func() Serializeable
+	Deserialize  func(vb *VariableBlob) (uint64, Serializeable, error)
+	Skip         func(vb *VariableBlob) (uint64, error)
+}
+
+// TypeRegistry maps fully qualified IDL names to type information
+type TypeRegistry struct {
+	mutex sync.RWMutex
+	types map[string]*TypeInfo
+}
+
+// Registry holds every generated type, keyed by fully qualified IDL name
+var Registry = NewTypeRegistry()
+
+// NewTypeRegistry factory
+func NewTypeRegistry() *TypeRegistry {
+	return &TypeRegistry{types: make(map[string]*TypeInfo)}
+}
+
+// Register adds a type to the registry, replacing any type with the same name
+func (r *TypeRegistry) Register(info *TypeInfo) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.types[info.Name] = info
+}
+
+// Lookup returns the type registered under a name
+func (r *TypeRegistry) Lookup(name string) (*TypeInfo, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	info, ok := r.types[name]
+	return info, ok
+}
+
+// Names returns every registered name in sorted order
+func (r *TypeRegistry) Names() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.types))
+	for name := range r.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *TypeRegistry) get(name string) (*TypeInfo, error) {
+	info, ok := r.Lookup(name)
+	if !ok {
+		return nil, errors.New("Unknown type: " + name)
+	}
+	return info, nil
+}
+
+// New returns the default value of a type
+func (r *TypeRegistry) New(name string) (Serializeable, error) {
+	info, err := r.get(name)
+	if err != nil {
+		return nil, err
+	}
+	return info.New(), nil
+}
+
+// Deserialize decodes a type from the front of a VariableBlob
+func (r *TypeRegistry) Deserialize(name string, vb *VariableBlob) (uint64, Serializeable, error) {
+	info, err := r.get(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	return info.Deserialize(vb)
+}
+
+// DeserializeExact decodes a type that must span the whole VariableBlob
+func (r *TypeRegistry) DeserializeExact(name string, vb *VariableBlob) (Serializeable, error) {
+	n, v, err := r.Deserialize(name, vb)
+	if err != nil {
+		return nil, err
+	}
+	if n != uint64(len(*vb)) {
+		return nil, ErrTrailingBytes
+	}
+	return v, nil
+}
+
+// DecodeJSON decodes a type from its JSON representation
+func (r *TypeRegistry) DecodeJSON(name string, data []byte) (Serializeable, error) {
+	v, err := r.New(name)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,26 @@
+package koinos
+
+import (
+	"errors"
+)
+
+// --------------------------------
+//  Serialized Size
+// --------------------------------
+
+// ErrSerializedSizeLimit is returned when a value would serialize to more bytes than allowed
+var ErrSerializedSizeLimit = errors.New("Serialized size exceeds limit")
+
+// SerializeToBlob serializes v into a buffer allocated once with the exact size
+func SerializeToBlob(v Serializeable) *VariableBlob {
+	return v.Serialize(NewVariableBlobWithCapacity(v.SerializedSize()))
+}
+
+// SerializeWithLimit serializes v like SerializeToBlob, failing before encoding when the result would exceed limit bytes
+func SerializeWithLimit(v Serializeable, limit int) (*VariableBlob, error) {
+	size := v.SerializedSize()
+	if size > limit {
+		return nil, ErrSerializedSizeLimit
+	}
+	return v.Serialize(NewVariableBlobWithCapacity(size)), nil
+}
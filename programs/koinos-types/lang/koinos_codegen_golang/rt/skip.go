@@ -0,0 +1,320 @@
+package koinos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/koinos/koinos-types-golang/internal/impl"
+)
+
+// --------------------------------
+//  Skip
+// --------------------------------
+
+// The SkipX functions return the length of an encoded X at the front of a VariableBlob. They validate
+// the encoding like DeserializeX, except inside opaque values, without allocating.
+
+func skipFixed(vb *VariableBlob, size uint64) (uint64, error) {
+	if uint64(len(*vb)) < size {
+		return 0, errors.New("Unexpected EOF")
+	}
+	return size, nil
+}
+
+// skipBlob returns the length of a varint length prefix and the bytes it covers
+func skipBlob(vb *VariableBlob) (uint64, uint64, error) {
+	size, bytes := impl.Uvarint(*vb)
+	if bytes <= 0 {
+		return 0, 0, errors.New("Could not deserialize variable blob size")
+	}
+	if uint64(len(*vb)-bytes) < size {
+		return 0, 0, errors.New("Unexpected EOF")
+	}
+	return uint64(bytes), size, nil
+}
+
+// SkipString function
+func SkipString(vb *VariableBlob) (uint64, error) {
+	prefix, size, err := skipBlob(vb)
+	if err != nil {
+		return 0, err
+	}
+	if !utf8.Valid((*vb)[prefix : prefix+size]) {
+		return 0, errors.New("String is not UTF-8 encoded")
+	}
+	return prefix + size, nil
+}
+
+// SkipBoolean function
+func SkipBoolean(vb *VariableBlob) (uint64, error) {
+	if len(*vb) < 1 {
+		return 0, errors.New("Unexpected EOF")
+	}
+	if (*vb)[0] > 1 {
+		return 0, errors.New("Boolean must be 0 or 1")
+	}
+	return 1, nil
+}
+
+// SkipInt8 function
+func SkipInt8(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 1)
+}
+
+// SkipUInt8 function
+func SkipUInt8(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 1)
+}
+
+// SkipInt16 function
+func SkipInt16(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 2)
+}
+
+// SkipUInt16 function
+func SkipUInt16(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 2)
+}
+
+// SkipInt32 function
+func SkipInt32(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 4)
+}
+
+// SkipUInt32 function
+func SkipUInt32(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 4)
+}
+
+// SkipInt64 function
+func SkipInt64(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 8)
+}
+
+// SkipUInt64 function
+func SkipUInt64(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 8)
+}
+
+// SkipInt128 function
+func SkipInt128(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 16)
+}
+
+// SkipUInt128 function
+func SkipUInt128(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 16)
+}
+
+// SkipInt160 function
+func SkipInt160(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 20)
+}
+
+// SkipUInt160 function
+func SkipUInt160(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 20)
+}
+
+// SkipInt256 function
+func SkipInt256(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 32)
+}
+
+// SkipUInt256 function
+func SkipUInt256(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 32)
+}
+
+// SkipVariableBlob function
+func SkipVariableBlob(vb *VariableBlob) (uint64, error) {
+	prefix, size, err := skipBlob(vb)
+	if err != nil {
+		return 0, err
+	}
+	return prefix + size, nil
+}
+
+// SkipTimestampType function
+func SkipTimestampType(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 8)
+}
+
+// SkipBlockHeightType function
+func SkipBlockHeightType(vb *VariableBlob) (uint64, error) {
+	return skipFixed(vb, 8)
+}
+
+// SkipMultihash function
+func SkipMultihash(vb *VariableBlob) (uint64, error) {
+	_, isize := impl.Uvarint(*vb)
+	if isize <= 0 {
+		return 0, errors.New("Could not deserialize multihash id")
+	}
+	rvb := (*vb)[isize:]
+	dsize, err := SkipVariableBlob(&rvb)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(isize) + dsize, nil
+}
+
+// --------------------------------
+//  Field Locator
+// --------------------------------
+
+// FieldRange is the byte range of a field in a serialized value
+type FieldRange struct {
+	Name   string
+	Offset int
+	Length int
+}
+
+// Offsets returns the byte range of every field of a serialized struct, in declaration order
+func Offsets(typeName string, data []byte) ([]FieldRange, error) {
+	info, err := Registry.get(typeName)
+	if err != nil {
+		return nil, err
+	}
+	for info.Kind == KindTypedef {
+		if info, err = Registry.get(info.Element); err != nil {
+			return nil, err
+		}
+	}
+	if info.Kind != KindStruct {
+		return nil, errors.New(typeName + " is not a struct")
+	}
+
+	ranges := make([]FieldRange, 0, len(info.Fields))
+	offset := 0
+	for _, field := range info.Fields {
+		n, err := skipType(field.TypeName, data[offset:])
+		if err != nil {
+			return nil, fmt.Errorf("%s at %s", err, field.Name)
+		}
+		ranges = append(ranges, FieldRange{Name: field.Name, Offset: offset, Length: n})
+		offset += n
+	}
+	return ranges, nil
+}
+
+// Locate returns the byte range of the value at a path in a serialized value. Paths are the ones used by
+// AnnotateSegments, for example "transactions[2].active_data.nonce", with variant and opaque values read
+// through. The range of an opaque path excludes its length prefix when more of the path follows it.
+func Locate(typeName string, data []byte, path string) (FieldRange, error) {
+	result := FieldRange{Name: path, Length: len(data)}
+	rest := path
+	for rest != "" {
+		info, err := Registry.get(typeName)
+		if err != nil {
+			return FieldRange{}, err
+		}
+		window := data[result.Offset : result.Offset+result.Length]
+
+		switch info.Kind {
+		case KindTypedef:
+			typeName = info.Element
+			continue
+
+		case KindOpaque:
+			vb := VariableBlob(window)
+			prefix, size, err := skipBlob(&vb)
+			if err != nil {
+				return FieldRange{}, err
+			}
+			result.Offset += int(prefix)
+			result.Length = int(size)
+			typeName = info.Element
+			continue
+
+		case KindVariant:
+			tag, bytes := impl.Uvarint(window)
+			if bytes <= 0 || tag >= uint64(len(info.Alternatives)) {
+				return FieldRange{}, errors.New("Could not decode variant tag at " + path)
+			}
+			result.Offset += bytes
+			result.Length -= bytes
+			typeName = info.Alternatives[tag]
+			continue
+
+		case KindStruct:
+			name := strings.TrimPrefix(rest, ".")
+			if end := strings.IndexAny(name, ".["); end >= 0 {
+				name, rest = name[:end], name[end:]
+			} else {
+				rest = ""
+			}
+
+			offset := 0
+			found := false
+			for _, field := range info.Fields {
+				n, err := skipType(field.TypeName, window[offset:])
+				if err != nil {
+					return FieldRange{}, fmt.Errorf("%s at %s", err, field.Name)
+				}
+				if field.Name == name {
+					result.Offset += offset
+					result.Length = n
+					typeName = field.TypeName
+					found = true
+					break
+				}
+				offset += n
+			}
+			if !found {
+				return FieldRange{}, errors.New("Unknown field " + name + " in " + info.Name)
+			}
+
+		case KindVector:
+			end := strings.IndexByte(rest, ']')
+			if !strings.HasPrefix(rest, "[") || end < 0 {
+				return FieldRange{}, errors.New("Expected an index in " + path)
+			}
+			index, err := strconv.ParseUint(rest[1:end], 10, 64)
+			if err != nil {
+				return FieldRange{}, errors.New("Invalid index in " + path)
+			}
+			rest = rest[end+1:]
+
+			count, bytes := impl.Uvarint(window)
+			if bytes <= 0 {
+				return FieldRange{}, errors.New("Could not decode vector length in " + path)
+			}
+			if index >= count {
+				return FieldRange{}, errors.New("Index out of range in " + path)
+			}
+			offset := bytes
+			for i := uint64(0); ; i++ {
+				n, err := skipType(info.Element, window[offset:])
+				if err != nil {
+					return FieldRange{}, err
+				}
+				if i == index {
+					result.Offset += offset
+					result.Length = n
+					break
+				}
+				offset += n
+			}
+			typeName = info.Element
+
+		default:
+			return FieldRange{}, errors.New("Cannot select " + rest + " in " + info.Name)
+		}
+	}
+
+	return result, nil
+}
+
+func skipType(typeName string, data []byte) (int, error) {
+	info, err := Registry.get(typeName)
+	if err != nil {
+		return 0, err
+	}
+	vb := VariableBlob(data)
+	n, err := info.Skip(&vb)
+	return int(n), err
+}
@@ -0,0 +1,433 @@
+package koinos
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// --------------------------------
+//  Wide Integer Limbs
+// --------------------------------
+
+// wide is a two's complement integer in little endian 64 bit limbs.
+// It holds any koinos integer and the sum or difference of any two of them.
+type wide [5]uint64
+
+func wideFromLimbs(limbs []uint64, signed bool) wide {
+	var w wide
+	copy(w[:], limbs)
+	if signed && int64(limbs[len(limbs)-1]) < 0 {
+		for i := len(limbs); i < len(w); i++ {
+			w[i] = ^uint64(0)
+		}
+	}
+	return w
+}
+
+func wideFromUint64(v uint64) wide {
+	return wide{v}
+}
+
+func wideFromInt64(v int64) wide {
+	w := wide{uint64(v)}
+	if v < 0 {
+		for i := 1; i < len(w); i++ {
+			w[i] = ^uint64(0)
+		}
+	}
+	return w
+}
+
+func (w *wide) store(limbs []uint64) {
+	copy(limbs, w[:len(limbs)])
+}
+
+func (w *wide) negative() bool {
+	return int64(w[len(w)-1]) < 0
+}
+
+func (w *wide) isZero() bool {
+	return *w == wide{}
+}
+
+// fits reports whether w is within the bounds of an integer of the given width
+func (w *wide) fits(width uint, signed bool) bool {
+	var fill uint64
+	start := width
+	if signed {
+		start--
+		if w.negative() {
+			fill = ^uint64(0)
+		}
+	} else if w.negative() {
+		return false
+	}
+
+	limb := start / 64
+	if limb >= uint(len(w)) {
+		return true
+	}
+	mask := ^uint64(0) << (start % 64)
+	if w[limb]&mask != fill&mask {
+		return false
+	}
+	for i := limb + 1; i < uint(len(w)); i++ {
+		if w[i] != fill {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *wide) add(o *wide) wide {
+	var r wide
+	var carry uint64
+	for i := range w {
+		r[i], carry = bits.Add64(w[i], o[i], carry)
+	}
+	return r
+}
+
+func (w *wide) sub(o *wide) wide {
+	var r wide
+	var borrow uint64
+	for i := range w {
+		r[i], borrow = bits.Sub64(w[i], o[i], borrow)
+	}
+	return r
+}
+
+func (w *wide) neg() wide {
+	var zero wide
+	return zero.sub(w)
+}
+
+func (w *wide) abs() wide {
+	if w.negative() {
+		return w.neg()
+	}
+	return *w
+}
+
+// cmpMagnitude compares the limbs as unsigned values
+func (w *wide) cmpMagnitude(o *wide) int {
+	for i := len(w) - 1; i >= 0; i-- {
+		if w[i] != o[i] {
+			if w[i] < o[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// mul returns false when the product does not fit in a wide
+func (w *wide) mul(o *wide) (wide, bool) {
+	neg := w.negative() != o.negative()
+	a, b := w.abs(), o.abs()
+
+	var p [2 * len(wide{})]uint64
+	for i := range a {
+		if a[i] == 0 {
+			continue
+		}
+		var carry uint64
+		for j := range b {
+			hi, lo := bits.Mul64(a[i], b[j])
+			var c uint64
+			lo, c = bits.Add64(lo, p[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			p[i+j] = lo
+			carry = hi
+		}
+		p[i+len(b)] = carry
+	}
+
+	var r wide
+	copy(r[:], p[:len(r)])
+	for _, limb := range p[len(r):] {
+		if limb != 0 {
+			return r, false
+		}
+	}
+	if r.negative() {
+		return r, false
+	}
+	if neg {
+		r = r.neg()
+	}
+	return r, true
+}
+
+func (w *wide) shl1() wide {
+	var r wide
+	for i := len(w) - 1; i > 0; i-- {
+		r[i] = w[i]<<1 | w[i-1]>>63
+	}
+	r[0] = w[0] << 1
+	return r
+}
+
+func (w *wide) bitLen() int {
+	for i := len(w) - 1; i >= 0; i-- {
+		if w[i] != 0 {
+			return i*64 + bits.Len64(w[i])
+		}
+	}
+	return 0
+}
+
+// quo divides, truncating toward zero. The divisor must not be zero.
+func (w *wide) quo(o *wide) wide {
+	neg := w.negative() != o.negative()
+	a, b := w.abs(), o.abs()
+
+	var q, r wide
+	if b[1]|b[2]|b[3]|b[4] == 0 {
+		for i := len(a) - 1; i >= 0; i-- {
+			q[i], r[0] = bits.Div64(r[0], a[i], b[0])
+		}
+	} else {
+		for i := a.bitLen() - 1; i >= 0; i-- {
+			r = r.shl1()
+			r[0] |= (a[i/64] >> (uint(i) % 64)) & 1
+			if r.cmpMagnitude(&b) >= 0 {
+				r = r.sub(&b)
+				q[i/64] |= 1 << (uint(i) % 64)
+			}
+		}
+	}
+
+	if neg {
+		q = q.neg()
+	}
+	return q
+}
+
+// mulAddSmall returns w * m + a, or false when the result overflows the top limb's sign bit
+func (w *wide) mulAddSmall(m, a uint64) (wide, bool) {
+	var r wide
+	carry := a
+	for i := range w {
+		hi, lo := bits.Mul64(w[i], m)
+		var c uint64
+		r[i], c = bits.Add64(lo, carry, 0)
+		carry = hi + c
+	}
+	return r, carry == 0 && !r.negative()
+}
+
+// parseWide parses an optionally signed integer in base 10 or 16.
+// ok is false for malformed input, inRange is false when the magnitude does not fit.
+func parseWide(s string, base uint64) (w wide, ok bool, inRange bool) {
+	neg := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return w, false, false
+	}
+
+	inRange = true
+	for i := 0; i < len(s); i++ {
+		var d uint64
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			d = uint64(c - '0')
+		case base == 16 && c >= 'a' && c <= 'f':
+			d = uint64(c-'a') + 10
+		case base == 16 && c >= 'A' && c <= 'F':
+			d = uint64(c-'A') + 10
+		default:
+			return w, false, false
+		}
+		if inRange {
+			w, inRange = w.mulAddSmall(base, d)
+		}
+	}
+
+	if neg {
+		w = w.neg()
+	}
+	return w, true, inRange
+}
+
+const wideDecimalChunk = 10000000000000000000 // 10^19
+
+// appendDecimal appends the base 10 representation of w
+func (w *wide) appendDecimal(dst []byte) []byte {
+	var buf [100]byte
+	i := len(buf)
+	m := w.abs()
+
+	for {
+		var rem uint64
+		for j := len(m) - 1; j >= 0; j-- {
+			m[j], rem = bits.Div64(rem, m[j], wideDecimalChunk)
+		}
+		last := m.isZero()
+		for k := 0; k < 19 && (rem != 0 || !last); k++ {
+			i--
+			buf[i] = byte('0' + rem%10)
+			rem /= 10
+		}
+		if last {
+			break
+		}
+	}
+
+	if i == len(buf) {
+		i--
+		buf[i] = '0'
+	}
+	if w.negative() {
+		i--
+		buf[i] = '-'
+	}
+	return append(dst, buf[i:]...)
+}
+
+// appendHex appends the base 16 representation of w with a 0x prefix
+func (w *wide) appendHex(dst []byte) []byte {
+	const digits = "0123456789abcdef"
+	if w.negative() {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, '0', 'x')
+
+	m := w.abs()
+	n := (m.bitLen() + 3) / 4
+	if n == 0 {
+		return append(dst, '0')
+	}
+	for i := n - 1; i >= 0; i-- {
+		dst = append(dst, digits[(m[i/16]>>(uint(i%16)*4))&0xF])
+	}
+	return dst
+}
+
+func (w *wide) bigInt() *big.Int {
+	m := w.abs()
+	var buf [len(wide{}) * 8]byte
+	for i := range buf {
+		buf[len(buf)-1-i] = byte(m[i/8] >> (uint(i%8) * 8))
+	}
+
+	v := new(big.Int).SetBytes(buf[:])
+	if w.negative() {
+		v.Neg(v)
+	}
+	return v
+}
+
+// wideFromBigInt returns false when v does not fit in a wide
+func wideFromBigInt(v *big.Int) (wide, bool) {
+	var w wide
+	if v.BitLen() >= len(w)*64 {
+		return w, false
+	}
+
+	var buf [len(wide{}) * 8]byte
+	new(big.Int).Abs(v).FillBytes(buf[:])
+	for i := range buf {
+		w[i/8] |= uint64(buf[len(buf)-1-i]) << (uint(i%8) * 8)
+	}
+
+	if v.Sign() < 0 {
+		w = w.neg()
+	}
+	return w, true
+}
+
+// appendLimbs appends the size byte big endian two's complement representation of limbs
+func appendLimbs(dst []byte, limbs []uint64, size int) []byte {
+	n := len(dst)
+	if cap(dst)-n < size {
+		dst = append(dst, make([]byte, size)...)
+	} else {
+		dst = dst[:n+size]
+	}
+
+	out := dst[n:]
+	for i := 0; i < size/8; i++ {
+		binary.BigEndian.PutUint64(out[size-8*(i+1):], limbs[i])
+	}
+	if rem := size % 8; rem != 0 {
+		top := limbs[size/8]
+		for j := 0; j < rem; j++ {
+			out[rem-1-j] = byte(top >> (uint(j) * 8))
+		}
+	}
+	return dst
+}
+
+// readLimbs reads a big endian two's complement integer into limbs, sign extending the top limb
+func readLimbs(limbs []uint64, src []byte, signed bool) {
+	size := len(src)
+	for i := 0; i < size/8; i++ {
+		limbs[i] = binary.BigEndian.Uint64(src[size-8*(i+1):])
+	}
+	if rem := size % 8; rem != 0 {
+		var top uint64
+		if signed && src[0]&0x80 != 0 {
+			top = ^uint64(0)
+		}
+		for j := 0; j < rem; j++ {
+			top = top<<8 | uint64(src[j])
+		}
+		limbs[size/8] = top
+	}
+}
+
+// validLimbs reports whether the bits of limbs above width hold the sign or zero extension of the value
+func validLimbs(limbs []uint64, width uint, signed bool) bool {
+	rem := width % 64
+	if rem == 0 {
+		return true
+	}
+
+	top := limbs[len(limbs)-1]
+	shift := 64 - rem
+	if signed {
+		return top == uint64(int64(top<<shift)>>shift)
+	}
+	return top == top<<shift>>shift
+}
+
+// cmpLimbs compares two integers of the same width without widening them
+func cmpLimbs(a, b []uint64, signed bool) int {
+	top := len(a) - 1
+	if signed && int64(a[top]^b[top]) < 0 {
+		if int64(a[top]) < 0 {
+			return -1
+		}
+		return 1
+	}
+	for i := top; i >= 0; i-- {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// marshalWideJSON encodes w as a number when it is safe for JavaScript, otherwise as a string
+func marshalWideJSON(w *wide) ([]byte, error) {
+	if w.fits(64, true) {
+		if i := int64(w[0]); i <= bigIntNumericLiteralMax && i >= bigIntNumericLiteralMin {
+			return w.appendDecimal(nil), nil
+		}
+	}
+
+	b := append(make([]byte, 0, 82), '"')
+	b = w.appendDecimal(b)
+	return append(b, '"'), nil
+}
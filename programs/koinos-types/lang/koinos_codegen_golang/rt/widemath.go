@@ -0,0 +1,609 @@
+package koinos
+
+import (
+	"errors"
+	"strings"
+)
+
+// --------------------------------
+//  Wide Integer Arithmetic
+// --------------------------------
+
+// ErrOverflow is returned when a result is outside the bounds of its type
+var ErrOverflow = errors.New("Integer overflow")
+
+// ErrDivideByZero is returned when dividing by zero
+var ErrDivideByZero = errors.New("Divide by zero")
+
+func wideFromHex(s string) (wide, error) {
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s) == 0 || s[0] == '-' || s[0] == '+' {
+		return wide{}, errors.New("Could not parse hex integer")
+	}
+
+	w, ok, inRange := parseWide(s, 16)
+	if !ok {
+		return w, errors.New("Could not parse hex integer")
+	}
+	if !inRange {
+		return w, ErrOverflow
+	}
+	if neg {
+		w = w.neg()
+	}
+	return w, nil
+}
+
+// --------------------------------
+//  Int128
+// --------------------------------
+
+func checkInt128(w *wide) (*Int128, error) {
+	if !w.fits(128, true) {
+		return nil, ErrOverflow
+	}
+	return newInt128FromWide(w), nil
+}
+
+// NewInt128FromUint64 factory
+func NewInt128FromUint64(value uint64) *Int128 {
+	w := wideFromUint64(value)
+	return newInt128FromWide(&w)
+}
+
+// NewInt128FromBytes factory, expecting the 16 byte big endian serialization
+func NewInt128FromBytes(data []byte) (*Int128, error) {
+	if len(data) != 16 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := Int128{}
+	readLimbs(result.limbs[:], data, true)
+	return &result, nil
+}
+
+// NewInt128FromHex factory, accepting an optional sign and 0x prefix
+func NewInt128FromHex(value string) (*Int128, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkInt128(&w)
+}
+
+// Add Int128
+func (n *Int128) Add(o *Int128) (*Int128, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkInt128(&r)
+}
+
+// Sub Int128
+func (n *Int128) Sub(o *Int128) (*Int128, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkInt128(&r)
+}
+
+// Mul Int128
+func (n *Int128) Mul(o *Int128) (*Int128, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkInt128(&r)
+}
+
+// Div Int128, truncating toward zero
+func (n *Int128) Div(o *Int128) (*Int128, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkInt128(&r)
+}
+
+// Cmp Int128, returning -1, 0 or +1
+func (n *Int128) Cmp(o *Int128) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], true)
+}
+
+// Uint64 Int128
+func (n *Int128) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes Int128, as the 16 byte big endian serialization
+func (n *Int128) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 16), n.limbs[:], 16)
+}
+
+// Hex Int128
+func (n *Int128) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
+
+// --------------------------------
+//  UInt128
+// --------------------------------
+
+func checkUInt128(w *wide) (*UInt128, error) {
+	if !w.fits(128, false) {
+		return nil, ErrOverflow
+	}
+	return newUInt128FromWide(w), nil
+}
+
+// NewUInt128FromUint64 factory
+func NewUInt128FromUint64(value uint64) *UInt128 {
+	w := wideFromUint64(value)
+	return newUInt128FromWide(&w)
+}
+
+// NewUInt128FromBytes factory, expecting the 16 byte big endian serialization
+func NewUInt128FromBytes(data []byte) (*UInt128, error) {
+	if len(data) != 16 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := UInt128{}
+	readLimbs(result.limbs[:], data, false)
+	return &result, nil
+}
+
+// NewUInt128FromHex factory, accepting an optional sign and 0x prefix
+func NewUInt128FromHex(value string) (*UInt128, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkUInt128(&w)
+}
+
+// Add UInt128
+func (n *UInt128) Add(o *UInt128) (*UInt128, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkUInt128(&r)
+}
+
+// Sub UInt128
+func (n *UInt128) Sub(o *UInt128) (*UInt128, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkUInt128(&r)
+}
+
+// Mul UInt128
+func (n *UInt128) Mul(o *UInt128) (*UInt128, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkUInt128(&r)
+}
+
+// Div UInt128, truncating toward zero
+func (n *UInt128) Div(o *UInt128) (*UInt128, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkUInt128(&r)
+}
+
+// Cmp UInt128, returning -1, 0 or +1
+func (n *UInt128) Cmp(o *UInt128) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], false)
+}
+
+// Uint64 UInt128
+func (n *UInt128) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes UInt128, as the 16 byte big endian serialization
+func (n *UInt128) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 16), n.limbs[:], 16)
+}
+
+// Hex UInt128
+func (n *UInt128) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
+
+// --------------------------------
+//  Int160
+// --------------------------------
+
+func checkInt160(w *wide) (*Int160, error) {
+	if !w.fits(160, true) {
+		return nil, ErrOverflow
+	}
+	return newInt160FromWide(w), nil
+}
+
+// NewInt160FromUint64 factory
+func NewInt160FromUint64(value uint64) *Int160 {
+	w := wideFromUint64(value)
+	return newInt160FromWide(&w)
+}
+
+// NewInt160FromBytes factory, expecting the 20 byte big endian serialization
+func NewInt160FromBytes(data []byte) (*Int160, error) {
+	if len(data) != 20 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := Int160{}
+	readLimbs(result.limbs[:], data, true)
+	return &result, nil
+}
+
+// NewInt160FromHex factory, accepting an optional sign and 0x prefix
+func NewInt160FromHex(value string) (*Int160, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkInt160(&w)
+}
+
+// Add Int160
+func (n *Int160) Add(o *Int160) (*Int160, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkInt160(&r)
+}
+
+// Sub Int160
+func (n *Int160) Sub(o *Int160) (*Int160, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkInt160(&r)
+}
+
+// Mul Int160
+func (n *Int160) Mul(o *Int160) (*Int160, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkInt160(&r)
+}
+
+// Div Int160, truncating toward zero
+func (n *Int160) Div(o *Int160) (*Int160, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkInt160(&r)
+}
+
+// Cmp Int160, returning -1, 0 or +1
+func (n *Int160) Cmp(o *Int160) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], true)
+}
+
+// Uint64 Int160
+func (n *Int160) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes Int160, as the 20 byte big endian serialization
+func (n *Int160) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 20), n.limbs[:], 20)
+}
+
+// Hex Int160
+func (n *Int160) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
+
+// --------------------------------
+//  UInt160
+// --------------------------------
+
+func checkUInt160(w *wide) (*UInt160, error) {
+	if !w.fits(160, false) {
+		return nil, ErrOverflow
+	}
+	return newUInt160FromWide(w), nil
+}
+
+// NewUInt160FromUint64 factory
+func NewUInt160FromUint64(value uint64) *UInt160 {
+	w := wideFromUint64(value)
+	return newUInt160FromWide(&w)
+}
+
+// NewUInt160FromBytes factory, expecting the 20 byte big endian serialization
+func NewUInt160FromBytes(data []byte) (*UInt160, error) {
+	if len(data) != 20 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := UInt160{}
+	readLimbs(result.limbs[:], data, false)
+	return &result, nil
+}
+
+// NewUInt160FromHex factory, accepting an optional sign and 0x prefix
+func NewUInt160FromHex(value string) (*UInt160, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkUInt160(&w)
+}
+
+// Add UInt160
+func (n *UInt160) Add(o *UInt160) (*UInt160, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkUInt160(&r)
+}
+
+// Sub UInt160
+func (n *UInt160) Sub(o *UInt160) (*UInt160, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkUInt160(&r)
+}
+
+// Mul UInt160
+func (n *UInt160) Mul(o *UInt160) (*UInt160, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkUInt160(&r)
+}
+
+// Div UInt160, truncating toward zero
+func (n *UInt160) Div(o *UInt160) (*UInt160, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkUInt160(&r)
+}
+
+// Cmp UInt160, returning -1, 0 or +1
+func (n *UInt160) Cmp(o *UInt160) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], false)
+}
+
+// Uint64 UInt160
+func (n *UInt160) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes UInt160, as the 20 byte big endian serialization
+func (n *UInt160) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 20), n.limbs[:], 20)
+}
+
+// Hex UInt160
+func (n *UInt160) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
+
+// --------------------------------
+//  Int256
+// --------------------------------
+
+func checkInt256(w *wide) (*Int256, error) {
+	if !w.fits(256, true) {
+		return nil, ErrOverflow
+	}
+	return newInt256FromWide(w), nil
+}
+
+// NewInt256FromUint64 factory
+func NewInt256FromUint64(value uint64) *Int256 {
+	w := wideFromUint64(value)
+	return newInt256FromWide(&w)
+}
+
+// NewInt256FromBytes factory, expecting the 32 byte big endian serialization
+func NewInt256FromBytes(data []byte) (*Int256, error) {
+	if len(data) != 32 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := Int256{}
+	readLimbs(result.limbs[:], data, true)
+	return &result, nil
+}
+
+// NewInt256FromHex factory, accepting an optional sign and 0x prefix
+func NewInt256FromHex(value string) (*Int256, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkInt256(&w)
+}
+
+// Add Int256
+func (n *Int256) Add(o *Int256) (*Int256, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkInt256(&r)
+}
+
+// Sub Int256
+func (n *Int256) Sub(o *Int256) (*Int256, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkInt256(&r)
+}
+
+// Mul Int256
+func (n *Int256) Mul(o *Int256) (*Int256, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkInt256(&r)
+}
+
+// Div Int256, truncating toward zero
+func (n *Int256) Div(o *Int256) (*Int256, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkInt256(&r)
+}
+
+// Cmp Int256, returning -1, 0 or +1
+func (n *Int256) Cmp(o *Int256) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], true)
+}
+
+// Uint64 Int256
+func (n *Int256) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes Int256, as the 32 byte big endian serialization
+func (n *Int256) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 32), n.limbs[:], 32)
+}
+
+// Hex Int256
+func (n *Int256) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
+
+// --------------------------------
+//  UInt256
+// --------------------------------
+
+func checkUInt256(w *wide) (*UInt256, error) {
+	if !w.fits(256, false) {
+		return nil, ErrOverflow
+	}
+	return newUInt256FromWide(w), nil
+}
+
+// NewUInt256FromUint64 factory
+func NewUInt256FromUint64(value uint64) *UInt256 {
+	w := wideFromUint64(value)
+	return newUInt256FromWide(&w)
+}
+
+// NewUInt256FromBytes factory, expecting the 32 byte big endian serialization
+func NewUInt256FromBytes(data []byte) (*UInt256, error) {
+	if len(data) != 32 {
+		return nil, errors.New("Unexpected byte length")
+	}
+	result := UInt256{}
+	readLimbs(result.limbs[:], data, false)
+	return &result, nil
+}
+
+// NewUInt256FromHex factory, accepting an optional sign and 0x prefix
+func NewUInt256FromHex(value string) (*UInt256, error) {
+	w, err := wideFromHex(value)
+	if err != nil {
+		return nil, err
+	}
+	return checkUInt256(&w)
+}
+
+// Add UInt256
+func (n *UInt256) Add(o *UInt256) (*UInt256, error) {
+	x, y := n.wide(), o.wide()
+	r := x.add(&y)
+	return checkUInt256(&r)
+}
+
+// Sub UInt256
+func (n *UInt256) Sub(o *UInt256) (*UInt256, error) {
+	x, y := n.wide(), o.wide()
+	r := x.sub(&y)
+	return checkUInt256(&r)
+}
+
+// Mul UInt256
+func (n *UInt256) Mul(o *UInt256) (*UInt256, error) {
+	x, y := n.wide(), o.wide()
+	r, ok := x.mul(&y)
+	if !ok {
+		return nil, ErrOverflow
+	}
+	return checkUInt256(&r)
+}
+
+// Div UInt256, truncating toward zero
+func (n *UInt256) Div(o *UInt256) (*UInt256, error) {
+	x, y := n.wide(), o.wide()
+	if y.isZero() {
+		return nil, ErrDivideByZero
+	}
+	r := x.quo(&y)
+	return checkUInt256(&r)
+}
+
+// Cmp UInt256, returning -1, 0 or +1
+func (n *UInt256) Cmp(o *UInt256) int {
+	return cmpLimbs(n.limbs[:], o.limbs[:], false)
+}
+
+// Uint64 UInt256
+func (n *UInt256) Uint64() (uint64, error) {
+	w := n.wide()
+	if !w.fits(64, false) {
+		return 0, ErrOverflow
+	}
+	return w[0], nil
+}
+
+// Bytes UInt256, as the 32 byte big endian serialization
+func (n *UInt256) Bytes() []byte {
+	return appendLimbs(make([]byte, 0, 32), n.limbs[:], 32)
+}
+
+// Hex UInt256
+func (n *UInt256) Hex() string {
+	w := n.wide()
+	return string(w.appendHex(nil))
+}
@@ -0,0 +1,47 @@
+package koinos
+
+import (
+	"unsafe"
+)
+
+// --------------------------------
+//  Zero Copy
+// --------------------------------
+
+// DecodeMode selects whether deserialized values share memory with their input
+type DecodeMode int
+
+const (
+	// CopyDecode values own all of their memory. The DeserializeX functions use this mode.
+	CopyDecode DecodeMode = iota
+
+	// ZeroCopyDecode is used by the DeserializeXZeroCopy functions. VariableBlob,
+	// String and Multihash digest values alias the input buffer, so decoding does
+	// not allocate for them. FixedBlob values are arrays and are always copied.
+	//
+	// Values decoded in this mode follow these lifetime rules:
+	//
+	//   - The input buffer must not be modified while any decoded value is in use.
+	//     Strings alias the buffer too, so modifying it breaks string immutability.
+	//   - Any decoded value keeps the whole input buffer reachable. Copy small
+	//     values that outlive a large buffer, for example with CopyVariableBlob.
+	//   - Aliased VariableBlobs have their capacity clipped, so appending to one
+	//     reallocates instead of overwriting the input buffer.
+	//   - Opaque values hold an aliased blob. Unbox decodes it in CopyDecode mode.
+	//
+	// Serializing an aliased value is always safe.
+	ZeroCopyDecode
+)
+
+// CopyVariableBlob returns a VariableBlob that does not alias its argument
+func CopyVariableBlob(vb VariableBlob) VariableBlob {
+	return append(make(VariableBlob, 0, len(vb)), vb...)
+}
+
+// aliasString returns a string sharing memory with b
+func aliasString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return *(*string)(unsafe.Pointer(&b))
+}
@@ -0,0 +1,79 @@
+package koinos_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestAnnotate(t *testing.T) {
+	op := koinos.NewCallContractOperation()
+	op.EntryPoint = 7
+	op.Args = koinos.VariableBlob{0x01, 0x02}
+
+	active := koinos.NewActiveTransactionData()
+	active.Nonce = 5
+	active.Operations = append(active.Operations, koinos.Operation{Value: op})
+
+	trx := koinos.NewTransaction()
+	trx.ID = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB, 0xCD}}
+	trx.ActiveData = *koinos.NewOpaqueActiveTransactionDataFromNative(*active)
+	trx.PassiveData = *koinos.NewOpaquePassiveTransactionDataFromBlob(&koinos.VariableBlob{0xFF})
+	data := *koinos.SerializeToBlob(trx)
+
+	segments, err := koinos.AnnotateSegments("koinos::protocol::transaction", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Segments tile the input
+	offset := 0
+	for _, s := range segments {
+		if s.Offset != offset {
+			t.Fatalf("Segment %s starts at %d, expected %d", s.Path, s.Offset, offset)
+		}
+		offset += s.Length
+	}
+	if offset != len(data) {
+		t.Errorf("Segments cover %d of %d bytes", offset, len(data))
+	}
+
+	dump, err := koinos.Annotate("koinos::protocol::transaction", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"000000     1  12 ",
+		" id.id = 0x12\n",
+		"id.digest <length> = 2\n",
+		"active_data <length> = ",
+		"active_data.nonce = 5\n",
+		"active_data.operations <length> = 1 item\n",
+		"active_data.operations[0] <tag> = 3 (CallContractOperation)\n",
+		"active_data.operations[0].entry_point = 7\n",
+		" active_data.operations[0].args = 2 bytes\n",
+		" passive_data <opaque> = 1 byte\n",
+	} {
+		if !strings.Contains(dump, line) {
+			t.Errorf("Dump does not contain %q:\n%s", line, dump)
+		}
+	}
+
+	dump, err = koinos.Annotate("koinos::protocol::transaction", data[:len(data)-1])
+	if err == nil || !strings.Contains(dump, "error: ") || !strings.Contains(dump, "active_data.nonce = 5") {
+		t.Errorf("Truncated input was not reported:\n%s", dump)
+	}
+	if _, err = koinos.Annotate("koinos::protocol::transaction", append(data, 0x00)); err != koinos.ErrTrailingBytes {
+		t.Errorf("Trailing bytes were not reported")
+	}
+	if _, err = koinos.Annotate("koinos::foobar", data); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	id := koinos.SystemCallIDApplyBlock
+	dump, _ = koinos.Annotate("koinos::chain::system_call_id", *koinos.SerializeToBlob(&id))
+	if !strings.Contains(dump, ". = SystemCallIDApplyBlock") {
+		t.Errorf("Enum name was not shown:\n%s", dump)
+	}
+}
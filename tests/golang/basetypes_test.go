@@ -447,8 +447,8 @@ func TestInt128(t *testing.T) {
 
 	expectedValue, _ := koinos.NewInt128FromString("-170141183460469231731687303715884105728")
 
-	if expectedValue.Value.Cmp(&integer2.Value) != 0 {
-		t.Errorf("*integer2 != Int128(-170141183460469231731687303715884105728) (%s != %s)", (*integer2).Value.String(), expectedValue.Value.String())
+	if *expectedValue != *integer2 {
+		t.Errorf("*integer2 != Int128(-170141183460469231731687303715884105728) (%s != %s)", integer2.String(), expectedValue.String())
 	}
 	if size != 16 {
 		t.Errorf("size != 16 (%d != 16)", size)
@@ -488,7 +488,7 @@ func TestUInt128(t *testing.T) {
 	if err != nil {
 		t.Errorf("err != nil")
 	}
-	if toBin.Value.Cmp(&fromBin.Value) != 0 {
+	if *toBin != *fromBin {
 		t.Errorf("toBin != fromBin")
 	}
 
@@ -521,7 +521,7 @@ func TestInt160(t *testing.T) {
 	if err != nil {
 		t.Errorf("err != nil")
 	}
-	if toBin.Value.Cmp(&fromBin.Value) != 0 {
+	if *toBin != *fromBin {
 		t.Errorf("toBin != fromBin")
 	}
 
@@ -555,7 +555,7 @@ func TestUInt160(t *testing.T) {
 	if err != nil {
 		t.Errorf("err != nil")
 	}
-	if toBin.Value.Cmp(&fromBin.Value) != 0 {
+	if *toBin != *fromBin {
 		t.Errorf("toBin != fromBin")
 	}
 
@@ -590,8 +590,8 @@ func TestInt256(t *testing.T) {
 	if err != nil {
 		t.Errorf("err != nil")
 	}
-	if toBin.Value.Cmp(&fromBin.Value) != 0 {
-		t.Errorf("toBin != fromBin (%s != %s)", toBin.Value.String(), fromBin.Value.String())
+	if *toBin != *fromBin {
+		t.Errorf("toBin != fromBin (%s != %s)", toBin.String(), fromBin.String())
 	}
 
 	vb := koinos.VariableBlob{
@@ -626,7 +626,7 @@ func TestUInt256(t *testing.T) {
 	if err != nil {
 		t.Errorf("err != nil")
 	}
-	if toBin.Value.Cmp(&fromBin.Value) != 0 {
+	if *toBin != *fromBin {
 		t.Errorf("toBin != fromBin")
 	}
 
@@ -806,6 +806,27 @@ func TestVariableBlob(t *testing.T) {
 	}
 }
 
+func TestOverlongVarint(t *testing.T) {
+	// Only the shortest encoding of a length is accepted, so decoded values serialize to their input
+	for _, data := range []koinos.VariableBlob{
+		{0x80, 0x00},
+		{0x81, 0x00, 0x2A},
+		{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00},
+	} {
+		if _, _, err := koinos.DeserializeVariableBlob(&data); err == nil {
+			t.Errorf("Decoded overlong length %x", []byte(data))
+		}
+		if _, err := koinos.SkipVariableBlob(&data); err == nil {
+			t.Errorf("Skipped overlong length %x", []byte(data))
+		}
+	}
+
+	variant := koinos.VariableBlob{0x80, 0x00}
+	if _, _, err := koinos.DeserializeSystemCallTarget(&variant); err == nil {
+		t.Errorf("Decoded overlong variant tag")
+	}
+}
+
 func TestBooleanJson(t *testing.T) {
 	value := koinos.Boolean(true)
 	bytes, err := json.Marshal(value)
@@ -991,8 +1012,8 @@ func TestInt128Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewInt128FromString("10")
@@ -1001,8 +1022,8 @@ func TestInt128Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -1213,8 +1234,8 @@ func TestUInt128Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewUInt128FromString("10")
@@ -1223,8 +1244,8 @@ func TestUInt128Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -1367,8 +1388,8 @@ func TestInt160Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewInt160FromString("10")
@@ -1377,8 +1398,8 @@ func TestInt160Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -1589,8 +1610,8 @@ func TestUInt160Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewUInt160FromString("10")
@@ -1599,8 +1620,8 @@ func TestUInt160Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -1743,8 +1764,8 @@ func TestInt256Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewInt256FromString("10")
@@ -1753,8 +1774,8 @@ func TestInt256Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -1965,8 +1986,8 @@ func TestUInt256Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	value, _ = koinos.NewUInt256FromString("10")
@@ -1975,8 +1996,8 @@ func TestUInt256Json(t *testing.T) {
 	if err != nil {
 		t.Errorf("An error occurred while decoding from JSON")
 	}
-	if (*value).Value.Cmp(&result.Value) != 0 {
-		t.Errorf("The resulting values are unequal (%s != %s)", result.Value.String(), value.Value.String())
+	if *value != result {
+		t.Errorf("The resulting values are unequal (%s != %s)", result.String(), value.String())
 	}
 
 	bytes = []byte("\"foobar\"")
@@ -0,0 +1,88 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestBlockView(t *testing.T) {
+	block := zeroCopyTestBlock()
+	block.Header.Height = 42
+	vb := koinos.SerializeToBlob(block)
+
+	for _, view := range []*koinos.BlockView{koinos.NewBlockView(vb), koinos.NewBlockViewZeroCopy(vb)} {
+		id, err := view.ID()
+		if err != nil || !bytes.Equal(*koinos.SerializeToBlob(id), *koinos.SerializeToBlob(&block.ID)) {
+			t.Errorf("Unexpected ID %v, %v", id, err)
+		}
+		header, err := view.Header()
+		if err != nil || header.Height != 42 {
+			t.Errorf("Unexpected header %v, %v", header, err)
+		}
+		active, err := view.ActiveData()
+		if err != nil || !active.IsBoxed() {
+			t.Errorf("Active data was not returned boxed, %v", err)
+		}
+		sig, err := view.SignatureData()
+		if err != nil || !bytes.Equal(*sig, block.SignatureData) {
+			t.Errorf("Unexpected signature data %v, %v", sig, err)
+		}
+
+		count, err := view.TransactionCount()
+		if err != nil || count != uint64(len(block.Transactions)) {
+			t.Fatalf("Unexpected transaction count %d, %v", count, err)
+		}
+		for i := uint64(0); i < count; i++ {
+			trx, err := view.Transaction(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !trx.ActiveData.IsBoxed() {
+				t.Errorf("Transaction %d was not returned boxed", i)
+			}
+			if !bytes.Equal(*koinos.SerializeToBlob(trx), *koinos.SerializeToBlob(&block.Transactions[i])) {
+				t.Errorf("Transaction %d does not match", i)
+			}
+			if id, err := view.TransactionID(i); err != nil || !bytes.Equal(id.Digest, block.Transactions[i].ID.Digest) {
+				t.Errorf("Unexpected transaction %d ID %v, %v", i, id, err)
+			}
+		}
+		if _, err = view.Transaction(count); err != koinos.ErrTransactionIndex {
+			t.Errorf("Unexpected error %v", err)
+		}
+
+		decoded, err := view.Block()
+		if err != nil || !bytes.Equal(*koinos.SerializeToBlob(decoded), *vb) {
+			t.Errorf("Block does not match, %v", err)
+		}
+	}
+}
+
+func TestBlockViewInvalid(t *testing.T) {
+	vb := koinos.SerializeToBlob(zeroCopyTestBlock())
+
+	// The header is readable when the transactions are truncated
+	truncated := (*vb)[:len(*vb)-1]
+	view := koinos.NewBlockView(&truncated)
+	if _, err := view.Header(); err != nil {
+		t.Error(err)
+	}
+	if _, err := view.TransactionCount(); err != nil {
+		t.Error(err)
+	}
+	if _, err := view.Transaction(0); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	trailing := append(*koinos.SerializeToBlob(zeroCopyTestBlock()), 0x00)
+	if _, err := koinos.NewBlockView(&trailing).Block(); err != koinos.ErrTrailingBytes {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	empty := koinos.VariableBlob{}
+	if _, err := koinos.NewBlockView(&empty).Header(); err == nil {
+		t.Errorf("err == nil")
+	}
+}
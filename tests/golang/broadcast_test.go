@@ -0,0 +1,158 @@
+package koinos_test
+
+import (
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+	"github.com/koinos/koinos-types-golang/broadcast"
+)
+
+func TestBroadcastRoundTrip(t *testing.T) {
+	bus := broadcast.NewLocalBus()
+	pub := broadcast.NewPublisher(bus, broadcast.DefaultKeys())
+	sub := broadcast.NewSubscriber(bus, broadcast.DefaultKeys())
+
+	var accepted []*koinos.BlockAccepted
+	if err := sub.OnBlockAccepted(func(msg *koinos.BlockAccepted) {
+		accepted = append(accepted, msg)
+	}); err != nil {
+		t.Error(err)
+	}
+
+	var irreversible []*koinos.BlockIrreversible
+	if err := sub.OnBlockIrreversible(func(msg *koinos.BlockIrreversible) {
+		irreversible = append(irreversible, msg)
+	}); err != nil {
+		t.Error(err)
+	}
+
+	var forks []*koinos.ForkHeads
+	if err := sub.OnForkHeads(func(msg *koinos.ForkHeads) {
+		forks = append(forks, msg)
+	}); err != nil {
+		t.Error(err)
+	}
+
+	var trxs []*koinos.TransactionAccepted
+	if err := sub.OnTransactionAccepted(func(msg *koinos.TransactionAccepted) {
+		trxs = append(trxs, msg)
+	}); err != nil {
+		t.Error(err)
+	}
+
+	block := koinos.NewBlockAccepted()
+	block.Block.Header.Height = 10
+	if err := pub.PublishBlockAccepted(block); err != nil {
+		t.Error(err)
+	}
+
+	topology := koinos.NewBlockIrreversible()
+	topology.Topology.Height = 7
+	topology.Topology.ID = koinos.Multihash{ID: 1, Digest: koinos.VariableBlob{0x01, 0x02}}
+	if err := pub.PublishBlockIrreversible(topology); err != nil {
+		t.Error(err)
+	}
+
+	heads := koinos.NewForkHeads()
+	heads.ForkHeads = append(heads.ForkHeads, topology.Topology)
+	heads.LastIrreversibleBlock = topology.Topology
+	if err := pub.PublishForkHeads(heads); err != nil {
+		t.Error(err)
+	}
+
+	trx := koinos.NewTransactionAccepted()
+	trx.Height = 11
+	if err := pub.PublishTransactionAccepted(trx); err != nil {
+		t.Error(err)
+	}
+
+	if len(accepted) != 1 || accepted[0].Block.Header.Height != 10 {
+		t.Errorf("block_accepted was not delivered")
+	}
+	if len(irreversible) != 1 || !irreversible[0].Topology.ID.Equals(&topology.Topology.ID) {
+		t.Errorf("block_irreversible was not delivered")
+	}
+	if len(forks) != 1 || len(forks[0].ForkHeads) != 1 || forks[0].LastIrreversibleBlock.Height != 7 {
+		t.Errorf("fork_heads was not delivered")
+	}
+	if len(trxs) != 1 || trxs[0].Height != 11 {
+		t.Errorf("transaction_accepted was not delivered")
+	}
+}
+
+func TestBroadcastBadMessage(t *testing.T) {
+	bus := broadcast.NewLocalBus()
+	sub := broadcast.NewSubscriber(bus, broadcast.DefaultKeys())
+
+	called := false
+	if err := sub.OnBlockIrreversible(func(msg *koinos.BlockIrreversible) {
+		called = true
+	}); err != nil {
+		t.Error(err)
+	}
+
+	var errKey string
+	sub.OnError(func(key string, err error) {
+		errKey = key
+	})
+
+	if err := bus.Publish(broadcast.DefaultKeys().BlockIrreversible, []byte{0x01}); err != nil {
+		t.Error(err)
+	}
+	if called {
+		t.Errorf("Handler was called for a malformed message")
+	}
+	if errKey != broadcast.DefaultKeys().BlockIrreversible {
+		t.Errorf("Error handler was not called for a malformed message")
+	}
+
+	errKey = ""
+	msg := koinos.NewBlockIrreversible()
+	vb := koinos.NewVariableBlob()
+	vb = msg.Serialize(vb)
+	if err := bus.Publish(broadcast.DefaultKeys().BlockIrreversible, append(*vb, 0x00)); err != nil {
+		t.Error(err)
+	}
+	if called {
+		t.Errorf("Handler was called for a message with extra bytes")
+	}
+	if errKey != broadcast.DefaultKeys().BlockIrreversible {
+		t.Errorf("Error handler was not called for a message with extra bytes")
+	}
+
+	if err := bus.Subscribe(broadcast.DefaultKeys().BlockAccepted, nil); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestBroadcastKeys(t *testing.T) {
+	bus := broadcast.NewLocalBus()
+	keys := broadcast.DefaultKeys()
+	keys.BlockIrreversible = "test.block.irreversible"
+	pub := broadcast.NewPublisher(bus, keys)
+	sub := broadcast.NewSubscriber(bus, keys)
+
+	var irreversible []*koinos.BlockIrreversible
+	if err := sub.OnBlockIrreversible(func(msg *koinos.BlockIrreversible) {
+		irreversible = append(irreversible, msg)
+	}); err != nil {
+		t.Error(err)
+	}
+
+	called := false
+	if err := bus.Subscribe(broadcast.DefaultKeys().BlockIrreversible, func(data []byte) {
+		called = true
+	}); err != nil {
+		t.Error(err)
+	}
+
+	if err := pub.PublishBlockIrreversible(koinos.NewBlockIrreversible()); err != nil {
+		t.Error(err)
+	}
+	if len(irreversible) != 1 {
+		t.Errorf("block_irreversible was not delivered with the configured key")
+	}
+	if called {
+		t.Errorf("block_irreversible was published with the default key")
+	}
+}
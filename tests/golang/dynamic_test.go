@@ -0,0 +1,215 @@
+package koinos_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+	"github.com/koinos/koinos-types-golang/dynamic"
+)
+
+func loadSchema(t *testing.T) *dynamic.Schema {
+	schema, err := dynamic.LoadSchema([]byte(koinos.SchemaJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func checkDynamicRoundTrip(t *testing.T, schema *dynamic.Schema, name string, v koinos.Serializeable) {
+	vb := koinos.NewVariableBlob()
+	vb = v.Serialize(vb)
+
+	n, tree, err := schema.Decode(name, *vb)
+	if err != nil {
+		t.Errorf("Could not decode %s: %s", name, err)
+		return
+	}
+	if n != uint64(len(*vb)) {
+		t.Errorf("Decoding %s consumed %d of %d bytes", name, n, len(*vb))
+	}
+
+	data, err := schema.Encode(name, tree)
+	if err != nil {
+		t.Errorf("Could not encode %s: %s", name, err)
+		return
+	}
+	if !bytes.Equal(*vb, data) {
+		t.Errorf("Dynamic encoding of %s does not match generated encoding", name)
+	}
+}
+
+func TestDynamicRegistryAgreement(t *testing.T) {
+	schema := loadSchema(t)
+
+	for _, name := range koinos.Registry.Names() {
+		v, err := koinos.Registry.New(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		checkDynamicRoundTrip(t, schema, name, v)
+	}
+}
+
+func TestDynamicPopulated(t *testing.T) {
+	schema := loadSchema(t)
+
+	op := koinos.NewCallContractOperation()
+	op.ContractID[0] = 0x42
+	op.EntryPoint = 7
+	op.Args = koinos.VariableBlob{0x01, 0x02, 0x03}
+
+	trx := koinos.NewTransaction()
+	trx.ID = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB, 0xCD}}
+	active := koinos.NewActiveTransactionData()
+	active.ResourceLimit = *koinos.NewUInt128FromUint64(1000)
+	active.Nonce = 5
+	active.Operations = append(active.Operations, koinos.Operation{Value: op})
+	trx.ActiveData = *koinos.NewOpaqueActiveTransactionDataFromNative(*active)
+	trx.SignatureData = koinos.VariableBlob{0x04, 0x05}
+
+	checkDynamicRoundTrip(t, schema, "koinos::protocol::transaction", trx)
+
+	u, _ := koinos.NewUInt256FromHex("0x8" + strings.Repeat("0", 63))
+	checkDynamicRoundTrip(t, schema, "koinos::uint256", u)
+
+	i, _ := koinos.NewInt128FromString("-12345")
+	checkDynamicRoundTrip(t, schema, "koinos::int128", i)
+
+	// Opaque contents that do not decode survive a round trip
+	block := koinos.NewBlock()
+	block.ActiveData = *koinos.NewOpaqueActiveBlockDataFromBlob(&koinos.VariableBlob{0xFF})
+	checkDynamicRoundTrip(t, schema, "koinos::protocol::block", block)
+}
+
+func TestDynamicJSONInput(t *testing.T) {
+	schema := loadSchema(t)
+
+	var tree interface{}
+	input := `{"id": 18, "digest": "z2"}`
+	if err := json.Unmarshal([]byte(input), &tree); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := schema.Encode("koinos::multihash", tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := koinos.Multihash{ID: 18, Digest: koinos.VariableBlob{0x01}}
+	vb := koinos.NewVariableBlob()
+	vb = expected.Serialize(vb)
+	if !bytes.Equal(*vb, data) {
+		t.Errorf("Multihash from JSON does not match")
+	}
+
+	data, err = schema.Encode("std::vector<koinos::uint32>", []interface{}{float64(1), "2", json.Number("3")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte{0x03, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 3}) {
+		t.Errorf("Unexpected vector encoding")
+	}
+}
+
+func TestDynamicErrors(t *testing.T) {
+	schema := loadSchema(t)
+
+	if _, err := schema.Encode("koinos::uint8", 256); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("koinos::int8", -129); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("koinos::uint64", 1.5); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("koinos::fixed_blob<20>", []byte{0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("koinos::protocol::call_contract_operation", map[string]interface{}{}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("koinos::foobar", 0); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := schema.Encode("std::vector<koinos::uint8", []interface{}{}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, _, err := schema.Decode("koinos::uint32", []byte{0x01, 0x02}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, _, err := schema.Decode("std::vector<koinos::uint8>", []byte{0x05, 0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, _, err := schema.Decode("koinos::protocol::operation", []byte{0x7F}); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	nop := map[string]interface{}{"extensions": map[string]interface{}{}}
+	if _, err := schema.Encode("koinos::protocol::operation", map[string]interface{}{"type": "koinos::protocol::nop_operation"}); err == nil {
+		t.Errorf("A variant without a value was encoded")
+	}
+	if _, err := schema.Encode("koinos::protocol::operation", map[string]interface{}{"type": "koinos::protocol::nop_operation", "value": nop}); err != nil {
+		t.Error(err)
+	}
+	nop["extension"] = map[string]interface{}{}
+	if _, err := schema.Encode("koinos::protocol::nop_operation", nop); err == nil {
+		t.Errorf("A struct with an unknown field was encoded")
+	}
+}
+
+func TestDynamicFixedWidthIntegers(t *testing.T) {
+	schema := loadSchema(t)
+
+	valid := []struct {
+		typeName string
+		value    interface{}
+		expected []byte
+		decoded  interface{}
+	}{
+		{"koinos::int8", -128, []byte{0x80}, int64(-128)},
+		{"koinos::int16", "-2", []byte{0xFF, 0xFE}, int64(-2)},
+		{"koinos::uint16", json.Number("65535"), []byte{0xFF, 0xFF}, uint64(65535)},
+		{"koinos::int32", float64(-1 << 31), []byte{0x80, 0, 0, 0}, int64(-1 << 31)},
+		{"koinos::int64", "9223372036854775807", []byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, int64(math.MaxInt64)},
+		{"koinos::int64", "-9223372036854775808", []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, int64(math.MinInt64)},
+		{"koinos::uint64", uint64(math.MaxUint64), []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, uint64(math.MaxUint64)},
+		{"koinos::uint64", new(big.Int).SetUint64(1 << 63), []byte{0x80, 0, 0, 0, 0, 0, 0, 0}, uint64(1 << 63)},
+		{"koinos::block_height_type", 7, []byte{0, 0, 0, 0, 0, 0, 0, 7}, uint64(7)},
+	}
+	for _, test := range valid {
+		data, err := schema.Encode(test.typeName, test.value)
+		if err != nil || !bytes.Equal(data, test.expected) {
+			t.Errorf("Encoding %v as %s gave %x, %v", test.value, test.typeName, data, err)
+			continue
+		}
+		_, v, err := schema.Decode(test.typeName, data)
+		if err != nil || v != test.decoded {
+			t.Errorf("Decoding %x as %s gave %v, %v", data, test.typeName, v, err)
+		}
+	}
+
+	invalid := []struct {
+		typeName string
+		value    interface{}
+	}{
+		{"koinos::int64", "9223372036854775808"},
+		{"koinos::int64", uint64(1 << 63)},
+		{"koinos::uint64", "18446744073709551616"},
+		{"koinos::uint64", -1},
+		{"koinos::uint32", int64(1 << 32)},
+		{"koinos::int16", float64(1 << 15)},
+		{"koinos::uint8", "0x01"},
+	}
+	for _, test := range invalid {
+		if _, err := schema.Encode(test.typeName, test.value); err == nil {
+			t.Errorf("%v was encoded as %s", test.value, test.typeName)
+		}
+	}
+}
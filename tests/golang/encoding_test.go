@@ -0,0 +1,154 @@
+package koinos_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestBinaryMarshalerRegistry(t *testing.T) {
+	for _, name := range koinos.Registry.Names() {
+		v, err := koinos.Registry.New(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		m, ok := v.(encoding.BinaryMarshaler)
+		if !ok {
+			t.Errorf("%s is not a BinaryMarshaler", name)
+			continue
+		}
+		data, err := m.MarshalBinary()
+		if err != nil {
+			t.Errorf("Could not marshal %s: %s", name, err)
+			continue
+		}
+		if !bytes.Equal(data, *koinos.SerializeToBlob(v)) {
+			t.Errorf("MarshalBinary of %s does not match Serialize", name)
+		}
+
+		o, _ := koinos.Registry.New(name)
+		u, ok := o.(encoding.BinaryUnmarshaler)
+		if !ok {
+			t.Errorf("%s is not a BinaryUnmarshaler", name)
+			continue
+		}
+		if err = u.UnmarshalBinary(data); err != nil {
+			t.Errorf("Could not unmarshal %s: %s", name, err)
+		}
+		if err = u.UnmarshalBinary(append(data, 0x00)); err != koinos.ErrTrailingBytes {
+			t.Errorf("Trailing bytes were accepted for %s", name)
+		}
+	}
+}
+
+func TestBinaryMarshalerGob(t *testing.T) {
+	block := zeroCopyTestBlock()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(block); err != nil {
+		t.Fatal(err)
+	}
+	var decoded koinos.Block
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(*koinos.SerializeToBlob(&decoded), *koinos.SerializeToBlob(block)) {
+		t.Errorf("Block did not survive a gob round trip")
+	}
+}
+
+func TestBinaryMarshalerInvalid(t *testing.T) {
+	id := koinos.ThunkID(1)
+	if _, err := id.MarshalBinary(); err == nil {
+		t.Errorf("An invalid enum was marshaled")
+	}
+
+	var n koinos.UInt32
+	if err := n.UnmarshalBinary([]byte{0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func checkTextMarshaler(t *testing.T, name string, v encoding.TextMarshaler, o encoding.TextUnmarshaler) {
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Errorf("Could not marshal %s: %s", name, err)
+		return
+	}
+
+	// The text form is the JSON form without quotes
+	j, _ := json.Marshal(v)
+	if !bytes.Equal(text, bytes.Trim(j, `"`)) {
+		t.Errorf("Text %s of %s does not match JSON %s", text, name, j)
+	}
+
+	if err = o.UnmarshalText(text); err != nil {
+		t.Errorf("Could not unmarshal %s: %s", name, err)
+	}
+	back, _ := o.(encoding.TextMarshaler).MarshalText()
+	if !bytes.Equal(text, back) {
+		t.Errorf("%s did not survive a text round trip", name)
+	}
+}
+
+func TestTextMarshaler(t *testing.T) {
+	mh := koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB, 0xCD}}
+	checkTextMarshaler(t, "multihash", mh, &koinos.Multihash{})
+
+	vb := koinos.VariableBlob{0x01, 0x02, 0x03}
+	checkTextMarshaler(t, "variable blob", vb, &koinos.VariableBlob{})
+
+	var fb koinos.FixedBlob20
+	fb[0] = 0x42
+	checkTextMarshaler(t, "fixed blob", fb, &koinos.FixedBlob20{})
+
+	u, _ := koinos.NewUInt256FromString("115792089237316195423570985008687907853269984665640564039457584007913129639935")
+	checkTextMarshaler(t, "uint256", *u, koinos.NewUInt256())
+
+	i, _ := koinos.NewInt128FromString("-42")
+	checkTextMarshaler(t, "int128", *i, koinos.NewInt128())
+
+	checkTextMarshaler(t, "enum", koinos.ThunkIDApplyBlock, koinos.NewThunkID())
+
+	var id koinos.ThunkID
+	if err := id.UnmarshalText([]byte("1")); err == nil {
+		t.Errorf("An invalid enum was unmarshaled")
+	}
+	if err := u.UnmarshalText([]byte("-1")); err == nil {
+		t.Errorf("A negative UInt256 was unmarshaled")
+	}
+
+	// Text marshalers allow leaf types as JSON object keys
+	m := map[koinos.FixedBlob20]int{fb: 1}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[koinos.FixedBlob20]int
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded[fb] != 1 {
+		t.Errorf("FixedBlob20 did not work as a JSON key")
+	}
+
+	// Wide integers marshal the same way whether or not they are addressable
+	i160, _ := koinos.NewInt160FromString("5")
+	i128, _ := koinos.NewInt128FromString("5")
+	values := map[string]interface{}{"int160": *i160, "int128": *i128}
+	if data, err = json.Marshal(values); err != nil || string(data) != `{"int128":5,"int160":5}` {
+		t.Errorf("Wide integer values marshaled as %s: %v", data, err)
+	}
+}
+
+func TestDeserializeUntrustedLength(t *testing.T) {
+	// A huge vector length must fail on the missing items instead of allocating for them
+	vb := koinos.VariableBlob{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x7F, 0x00}
+	if _, _, err := koinos.DeserializeVectorTransaction(&vb); err == nil {
+		t.Errorf("err == nil")
+	}
+}
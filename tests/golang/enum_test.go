@@ -0,0 +1,100 @@
+package koinos_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestEnumValues(t *testing.T) {
+	values := koinos.ThunkIDValues()
+	if len(values) == 0 || values[0] != koinos.ThunkIDPrints {
+		t.Fatalf("Unexpected values %v", values)
+	}
+	for _, v := range values {
+		if !koinos.IsValidThunkID(v) {
+			t.Errorf("Invalid value %d", v)
+		}
+
+		p, err := koinos.ParseThunkID(v.String())
+		if err != nil || p != v {
+			t.Errorf("String form of %d did not parse: %v", v, err)
+		}
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	for _, s := range []string{"apply_block", "SystemCallIDApplyBlock", "2494255093"} {
+		v, err := koinos.ParseSystemCallID(s)
+		if err != nil {
+			t.Error(err)
+		} else if v != koinos.SystemCallIDApplyBlock {
+			t.Errorf("%s parsed as %d", s, v)
+		}
+	}
+
+	for _, s := range []string{"", "ApplyBlock", "1", "-1", "apply_block "} {
+		if _, err := koinos.ParseSystemCallID(s); err == nil {
+			t.Errorf("%q was parsed", s)
+		}
+	}
+}
+
+func TestEnumJSONNames(t *testing.T) {
+	id := koinos.ThunkIDApplyBlock
+
+	data, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "2372743592" {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	koinos.SetJSONEnumNames(true)
+	defer koinos.SetJSONEnumNames(false)
+
+	data, err = json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"apply_block"` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+	text, err := id.MarshalText()
+	if err != nil || string(text) != "apply_block" {
+		t.Errorf("Unexpected text %s", text)
+	}
+
+	for _, in := range []string{`"apply_block"`, `"ThunkIDApplyBlock"`, `2372743592`, `"2372743592"`} {
+		var v koinos.ThunkID
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Error(err)
+		} else if v != id {
+			t.Errorf("%s decoded as %d", in, v)
+		}
+	}
+
+	var v koinos.ThunkID
+	if err := json.Unmarshal([]byte(`"prints_"`), &v); err == nil {
+		t.Errorf("An unknown name was decoded")
+	}
+}
+
+func TestEnumMarshalInvalid(t *testing.T) {
+	invalid := koinos.ThunkID(5)
+
+	if _, err := json.Marshal(invalid); err == nil {
+		t.Errorf("An invalid enum was marshaled to JSON")
+	}
+	if _, err := invalid.MarshalText(); err == nil {
+		t.Errorf("An invalid enum was marshaled to text")
+	}
+
+	op := koinos.NewSetSystemCallOperation()
+	op.Target = koinos.SystemCallTarget{Value: &invalid}
+	if _, err := json.Marshal(op); err == nil {
+		t.Errorf("A struct holding an invalid enum was marshaled to JSON")
+	}
+}
@@ -0,0 +1,121 @@
+package koinos_test
+
+import (
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func makeTopology(id byte, height uint64, previous byte) koinos.BlockTopology {
+	t := koinos.NewBlockTopology()
+	t.ID = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{id}}
+	t.Height = koinos.BlockHeightType(height)
+	t.Previous = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{previous}}
+	return *t
+}
+
+func TestForkTree(t *testing.T) {
+	genesis := makeTopology(0x00, 0, 0xFF)
+	tree := koinos.NewForkTree(genesis)
+
+	// 0 <- 1 <- 2 <- 3
+	//        \- 4 <- 5
+	//             \- 6
+	blocks := []koinos.BlockTopology{
+		makeTopology(0x01, 1, 0x00),
+		makeTopology(0x02, 2, 0x01),
+		makeTopology(0x03, 3, 0x02),
+		makeTopology(0x04, 2, 0x01),
+		makeTopology(0x05, 3, 0x04),
+		makeTopology(0x06, 3, 0x04),
+	}
+	for _, b := range blocks {
+		if err := tree.Add(b); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if err := tree.Add(blocks[0]); err != nil {
+		t.Errorf("Adding a known block returned an error: %s", err)
+	}
+	if err := tree.Add(makeTopology(0x07, 4, 0x08)); err == nil {
+		t.Errorf("Added a block with an unknown previous block")
+	}
+	if err := tree.Add(makeTopology(0x07, 5, 0x03)); err == nil {
+		t.Errorf("Added a block with a height gap")
+	}
+
+	heads := tree.Heads()
+	if len(heads) != 3 {
+		t.Errorf("Expected 3 fork heads, got %d", len(heads))
+	}
+
+	head := tree.Head()
+	if !head.ID.Equals(&blocks[2].ID) {
+		t.Errorf("Head was not the first block seen at the highest height")
+	}
+
+	a, err := tree.AncestorAt(blocks[5].ID, 1)
+	if err != nil {
+		t.Error(err)
+	} else if !a.ID.Equals(&blocks[0].ID) {
+		t.Errorf("Unexpected ancestor")
+	}
+
+	if _, err = tree.AncestorAt(blocks[5].ID, 4); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	c, err := tree.CommonAncestor(blocks[2].ID, blocks[5].ID)
+	if err != nil {
+		t.Error(err)
+	} else if !c.ID.Equals(&blocks[0].ID) {
+		t.Errorf("Unexpected common ancestor")
+	}
+
+	c, err = tree.CommonAncestor(blocks[4].ID, blocks[5].ID)
+	if err != nil {
+		t.Error(err)
+	} else if !c.ID.Equals(&blocks[3].ID) {
+		t.Errorf("Unexpected common ancestor")
+	}
+
+	// Pruning removes the 1 <- 2 <- 3 fork
+	if err = tree.SetLastIrreversible(blocks[3].ID); err != nil {
+		t.Error(err)
+	}
+	if tree.Contains(blocks[1].ID) || tree.Contains(blocks[2].ID) || tree.Contains(blocks[0].ID) {
+		t.Errorf("Pruned blocks are still in the tree")
+	}
+
+	fh := koinos.NewForkHeadsFromTree(tree)
+	if len(fh.ForkHeads) != 2 {
+		t.Errorf("Expected 2 fork heads, got %d", len(fh.ForkHeads))
+	}
+	if !fh.LastIrreversibleBlock.ID.Equals(&blocks[3].ID) {
+		t.Errorf("Unexpected last irreversible block")
+	}
+
+	head = tree.Head()
+	if !head.ID.Equals(&blocks[4].ID) {
+		t.Errorf("Head was not the first block seen at the highest height")
+	}
+
+	// A head added later at the same height follows the others, whatever its ID
+	late := makeTopology(0x02, 3, 0x04)
+	if err = tree.Add(late); err != nil {
+		t.Error(err)
+	}
+	heads = tree.Heads()
+	if len(heads) != 3 || !heads[0].ID.Equals(&head.ID) || !heads[1].ID.Equals(&blocks[5].ID) || !heads[2].ID.Equals(&late.ID) {
+		t.Errorf("Fork heads at the same height are not in the order they were added")
+	}
+
+	if err = tree.Add(makeTopology(0x07, 2, 0x01)); err == nil {
+		t.Errorf("Added a block at the last irreversible height")
+	}
+
+	if err = tree.SetLastIrreversible(blocks[0].ID); err == nil {
+		t.Errorf("Moved the last irreversible block to a pruned block")
+	}
+}
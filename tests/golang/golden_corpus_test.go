@@ -0,0 +1,323 @@
+package koinos_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+// errGoldenChanged is returned when an update would change or remove existing golden vectors
+var errGoldenChanged = errors.New("Golden vectors changed")
+
+// sha256MultihashID is the multihash code of sha2-256
+const sha256MultihashID = 0x12
+
+// goldenVector is the expected encoding of one value of a registered type. Name is the type name and the
+// case, for example "koinos::uint64/max". Multihash is the sha2-256 multihash of the binary encoding.
+type goldenVector struct {
+	Name      string           `json:"name"`
+	Type      string           `json:"type"`
+	JSON      json.RawMessage  `json:"json"`
+	Hex       string           `json:"hex"`
+	Multihash koinos.Multihash `json:"multihash"`
+}
+
+// goldenCorpus is a versioned set of golden vectors. The version increases whenever an existing vector
+// changes or is removed, so consumers can tell an encoding change from added coverage.
+type goldenCorpus struct {
+	Version uint64         `json:"version"`
+	Vectors []goldenVector `json:"vectors"`
+}
+
+type goldenSample struct {
+	Case string
+	Data []byte
+}
+
+// newGoldenVector builds the golden vector of a serialized value
+func newGoldenVector(typeName string, caseName string, data []byte) (*goldenVector, error) {
+	v, err := koinos.Registry.DeserializeExact(typeName, (*koinos.VariableBlob)(&data))
+	if err != nil {
+		return nil, fmt.Errorf("%s/%s: %s", typeName, caseName, err)
+	}
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s/%s: %s", typeName, caseName, err)
+	}
+	return &goldenVector{
+		Name:      typeName + "/" + caseName,
+		Type:      typeName,
+		JSON:      j,
+		Hex:       hex.EncodeToString(data),
+		Multihash: goldenHash(data),
+	}, nil
+}
+
+func goldenHash(data []byte) koinos.Multihash {
+	h := sha256.Sum256(data)
+	return koinos.Multihash{ID: sha256MultihashID, Digest: koinos.VariableBlob(h[:])}
+}
+
+// check verifies that the vector decodes, encodes, and converts to and from JSON as recorded
+func (g *goldenVector) check() error {
+	data, err := hex.DecodeString(g.Hex)
+	if err != nil {
+		return fmt.Errorf("%s: %s", g.Name, err)
+	}
+	if h := goldenHash(data); !h.Equals(&g.Multihash) {
+		return fmt.Errorf("%s: Multihash does not match the binary encoding", g.Name)
+	}
+
+	v, err := koinos.Registry.DeserializeExact(g.Type, (*koinos.VariableBlob)(&data))
+	if err != nil {
+		return fmt.Errorf("%s: %s", g.Name, err)
+	}
+	if out := v.Serialize(koinos.NewVariableBlob()); !bytes.Equal(*out, data) {
+		return fmt.Errorf("%s: Decoded value serializes to %x", g.Name, []byte(*out))
+	}
+
+	j, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("%s: %s", g.Name, err)
+	}
+	var expected bytes.Buffer
+	if err = json.Compact(&expected, g.JSON); err != nil {
+		return fmt.Errorf("%s: %s", g.Name, err)
+	}
+	if !bytes.Equal(j, expected.Bytes()) {
+		return fmt.Errorf("%s: Decoded value marshals to %s", g.Name, j)
+	}
+
+	v, err = koinos.Registry.DecodeJSON(g.Type, g.JSON)
+	if err != nil {
+		return fmt.Errorf("%s: %s", g.Name, err)
+	}
+	if out := v.Serialize(koinos.NewVariableBlob()); !bytes.Equal(*out, data) {
+		return fmt.Errorf("%s: JSON value serializes to %x", g.Name, []byte(*out))
+	}
+	return nil
+}
+
+func (g *goldenVector) equals(o *goldenVector) bool {
+	var a, b bytes.Buffer
+	if json.Compact(&a, g.JSON) != nil || json.Compact(&b, o.JSON) != nil {
+		return false
+	}
+	return g.Type == o.Type && g.Hex == o.Hex && g.Multihash.Equals(&o.Multihash) && bytes.Equal(a.Bytes(), b.Bytes())
+}
+
+// update replaces the vectors of the corpus and returns the names of the existing vectors that changed or
+// were removed. Unless forced, such changes return errGoldenChanged and leave the corpus untouched.
+// Forced changes increase the version.
+func (c *goldenCorpus) update(vectors []goldenVector, force bool) ([]string, error) {
+	updated := make(map[string]*goldenVector, len(vectors))
+	for i := range vectors {
+		updated[vectors[i].Name] = &vectors[i]
+	}
+
+	var changed []string
+	for i := range c.Vectors {
+		if v, ok := updated[c.Vectors[i].Name]; !ok || !v.equals(&c.Vectors[i]) {
+			changed = append(changed, c.Vectors[i].Name)
+		}
+	}
+
+	if len(changed) > 0 {
+		if !force {
+			return changed, errGoldenChanged
+		}
+		c.Version++
+	}
+	c.Vectors = vectors
+	return changed, nil
+}
+
+// goldenVectors returns the golden vectors of every registered type: the default value, the extremes of
+// integers and fixed blobs, empty and populated vectors and blobs, every enum value and every variant tag.
+func goldenVectors() ([]goldenVector, error) {
+	g := goldenGenerator{samples: make(map[string][]goldenSample), pending: make(map[string]bool)}
+
+	var vectors []goldenVector
+	for _, name := range koinos.Registry.Names() {
+		samples, err := g.get(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range samples {
+			v, err := newGoldenVector(name, s.Case, s.Data)
+			if err != nil {
+				return nil, err
+			}
+			vectors = append(vectors, *v)
+		}
+	}
+	return vectors, nil
+}
+
+type goldenGenerator struct {
+	samples map[string][]goldenSample
+	pending map[string]bool
+}
+
+var goldenIntWidths = map[string]int{
+	"koinos::int8": 1, "koinos::int16": 2, "koinos::int32": 4, "koinos::int64": 8,
+	"koinos::int128": 16, "koinos::int160": 20, "koinos::int256": 32,
+	"koinos::uint8": 1, "koinos::uint16": 2, "koinos::uint32": 4, "koinos::uint64": 8,
+	"koinos::uint128": 16, "koinos::uint160": 20, "koinos::uint256": 32,
+	"koinos::timestamp_type": 8, "koinos::block_height_type": 8,
+}
+
+var goldenSigned = map[string]bool{
+	"koinos::int8": true, "koinos::int16": true, "koinos::int32": true, "koinos::int64": true,
+	"koinos::int128": true, "koinos::int160": true, "koinos::int256": true,
+}
+
+func goldenUvarint(x uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return buf[:binary.PutUvarint(buf, x)]
+}
+
+func goldenBlob(data []byte) []byte {
+	return append(goldenUvarint(uint64(len(data))), data...)
+}
+
+func goldenFill(size int, b byte) []byte {
+	return bytes.Repeat([]byte{b}, size)
+}
+
+// get returns the samples of a type, the default value first. The last sample is the most populated one
+// and is what enclosing types are built from. Recursive types only use the default value of themselves.
+func (g *goldenGenerator) get(name string) ([]goldenSample, error) {
+	if samples, ok := g.samples[name]; ok {
+		return samples, nil
+	}
+	info, ok := koinos.Registry.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("Unknown type %s", name)
+	}
+	def := *info.New().Serialize(koinos.NewVariableBlob())
+	if g.pending[name] {
+		return []goldenSample{{"default", def}}, nil
+	}
+	g.pending[name] = true
+	defer delete(g.pending, name)
+
+	samples := []goldenSample{{"default", def}}
+	add := func(c string, data []byte) {
+		samples = append(samples, goldenSample{c, data})
+	}
+	last := func(typeName string) ([]byte, error) {
+		s, err := g.get(typeName)
+		if err != nil {
+			return nil, err
+		}
+		return s[len(s)-1].Data, nil
+	}
+
+	switch info.Kind {
+	case koinos.KindBase:
+		if width, ok := goldenIntWidths[name]; ok {
+			if goldenSigned[name] {
+				min := goldenFill(width, 0)
+				min[0] = 0x80
+				max := goldenFill(width, 0xff)
+				max[0] = 0x7f
+				add("min", min)
+				add("max", max)
+			} else {
+				add("max", goldenFill(width, 0xff))
+			}
+			break
+		}
+		switch name {
+		case "koinos::boolean":
+			add("true", []byte{1})
+		case "std::string":
+			add("populated", goldenBlob([]byte("koinos ✓")))
+		case "koinos::variable_blob":
+			add("populated", goldenBlob([]byte{0x00, 0x01, 0x7f, 0x80, 0xff}))
+		case "koinos::multihash":
+			maxID := goldenUvarint(^uint64(0))
+			add("max_id", append(maxID, 0))
+			digest := make([]byte, 32)
+			for i := range digest {
+				digest[i] = byte(i)
+			}
+			add("populated", append(goldenUvarint(sha256MultihashID), goldenBlob(digest)...))
+		}
+
+	case koinos.KindFixedBlob:
+		add("max", goldenFill(info.Size, 0xff))
+
+	case koinos.KindTypedef:
+		return g.alias(name, info.Element)
+
+	case koinos.KindEnum:
+		for _, value := range info.Values {
+			v, err := koinos.Registry.DecodeJSON(name, []byte(strconv.Quote(value)))
+			if err != nil {
+				return nil, err
+			}
+			add("value_"+value, *v.Serialize(koinos.NewVariableBlob()))
+		}
+
+	case koinos.KindVector:
+		elems, err := g.get(info.Element)
+		if err != nil {
+			return nil, err
+		}
+		data := goldenUvarint(2)
+		data = append(data, elems[0].Data...)
+		data = append(data, elems[len(elems)-1].Data...)
+		add("populated", data)
+
+	case koinos.KindOpaque:
+		elem, err := last(info.Element)
+		if err != nil {
+			return nil, err
+		}
+		add("populated", goldenBlob(elem))
+
+	case koinos.KindVariant:
+		for tag, alternative := range info.Alternatives {
+			value, err := last(alternative)
+			if err != nil {
+				return nil, err
+			}
+			add("tag_"+strconv.Itoa(tag), append(goldenUvarint(uint64(tag)), value...))
+		}
+
+	case koinos.KindStruct:
+		var data []byte
+		for _, field := range info.Fields {
+			value, err := last(field.TypeName)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, value...)
+		}
+		if !bytes.Equal(data, def) {
+			add("populated", data)
+		}
+	}
+
+	g.samples[name] = samples
+	return samples, nil
+}
+
+// alias gives a typedef the samples of the type it names
+func (g *goldenGenerator) alias(name string, element string) ([]goldenSample, error) {
+	samples, err := g.get(element)
+	if err != nil {
+		return nil, err
+	}
+	g.samples[name] = samples
+	return samples, nil
+}
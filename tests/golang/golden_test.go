@@ -0,0 +1,154 @@
+package koinos_test
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+// Regenerate the corpus with
+//
+//	go test ./tests/golang -run TestGoldenVectors -update-golden
+//
+// which adds new vectors and refuses to change or remove existing ones unless -force-golden is also given.
+var (
+	updateGolden = flag.Bool("update-golden", false, "Add new golden vectors to the corpus")
+	forceGolden  = flag.Bool("force-golden", false, "Allow -update-golden to change or remove existing golden vectors")
+)
+
+var goldenPath = filepath.Join("testdata", "golden_vectors.json")
+
+func loadGolden(t *testing.T) *goldenCorpus {
+	corpus := &goldenCorpus{Version: 1}
+	data, err := ioutil.ReadFile(goldenPath)
+	if os.IsNotExist(err) && *updateGolden {
+		return corpus
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(data, corpus); err != nil {
+		t.Fatal(err)
+	}
+	return corpus
+}
+
+func TestGoldenVectors(t *testing.T) {
+	corpus := loadGolden(t)
+	vectors, err := goldenVectors()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *updateGolden {
+		changed, err := corpus.update(vectors, *forceGolden)
+		if errors.Is(err, errGoldenChanged) {
+			t.Fatalf("%s, use -force-golden to change:\n   %s", err, strings.Join(changed, "\n   "))
+		}
+		data, err := json.MarshalIndent(corpus, "", "   ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = os.MkdirAll(filepath.Dir(goldenPath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(goldenPath, append(data, '\n'), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := make(map[string]bool, len(corpus.Vectors))
+	for i := range corpus.Vectors {
+		names[corpus.Vectors[i].Name] = true
+		if err := corpus.Vectors[i].check(); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, v := range vectors {
+		if !names[v.Name] {
+			t.Errorf("%s is missing from the corpus, run with -update-golden", v.Name)
+		}
+	}
+}
+
+func TestGoldenVectorsCoverVariants(t *testing.T) {
+	names := make(map[string]bool)
+	vectors, err := goldenVectors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		names[v.Name] = true
+	}
+
+	if !names["koinos::uint256/max"] || !names["koinos::int8/min"] {
+		t.Error("Integer extremes are missing")
+	}
+	for _, name := range koinos.Registry.Names() {
+		info, _ := koinos.Registry.Lookup(name)
+		for tag := range info.Alternatives {
+			if !names[name+"/tag_"+strconv.Itoa(tag)] {
+				t.Errorf("%s has no vector for tag %d", name, tag)
+			}
+		}
+	}
+}
+
+func TestGoldenUpdateRefusesChanges(t *testing.T) {
+	vectors, err := goldenVectors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	corpus := &goldenCorpus{Version: 1}
+	if _, err = corpus.update(vectors[:2], false); err != nil {
+		t.Fatal(err)
+	}
+
+	// Adding vectors is not a change
+	if _, err = corpus.update(vectors[:3], false); err != nil || corpus.Version != 1 {
+		t.Fatalf("Adding vectors failed: %v, version %d", err, corpus.Version)
+	}
+
+	changed := append([]goldenVector(nil), vectors[:3]...)
+	changed[1].Hex += "00"
+	names, err := corpus.update(changed, false)
+	if !errors.Is(err, errGoldenChanged) || len(names) != 1 || names[0] != vectors[1].Name {
+		t.Fatalf("Expected a refused change of %s, got %v %v", vectors[1].Name, names, err)
+	}
+	if corpus.Vectors[1].Hex != vectors[1].Hex || corpus.Version != 1 {
+		t.Fatal("Refused update changed the corpus")
+	}
+
+	if _, err = corpus.update(vectors[1:3], false); !errors.Is(err, errGoldenChanged) {
+		t.Fatal("Removing a vector was not refused")
+	}
+	if _, err = corpus.update(changed, true); err != nil || corpus.Version != 2 || corpus.Vectors[1].Hex != changed[1].Hex {
+		t.Fatalf("Forced update failed: %v, version %d", err, corpus.Version)
+	}
+}
+
+func TestGoldenCheckDetectsMismatch(t *testing.T) {
+	vectors, err := goldenVectors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		if v.Name != "koinos::uint64/max" {
+			continue
+		}
+		v.JSON = json.RawMessage(`"1"`)
+		if err = v.check(); err == nil {
+			t.Error("Mismatched JSON was not detected")
+		}
+		return
+	}
+	t.Fatal("koinos::uint64/max not found")
+}
@@ -0,0 +1,238 @@
+package koinos_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+// validateJSONSchema checks a decoded JSON value against the subset of JSON Schema used by the generated definitions
+func validateJSONSchema(schema map[string]interface{}, defs map[string]interface{}, v interface{}) bool {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, ok := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]interface{})
+		if !ok || !validateJSONSchema(def, defs, v) {
+			return false
+		}
+	}
+
+	if t, ok := schema["type"].(string); ok {
+		switch t {
+		case "null":
+			if v != nil {
+				return false
+			}
+		case "boolean":
+			if _, ok := v.(bool); !ok {
+				return false
+			}
+		case "string":
+			if _, ok := v.(string); !ok {
+				return false
+			}
+		case "integer":
+			n, ok := v.(json.Number)
+			if !ok {
+				return false
+			}
+			if _, ok := new(big.Int).SetString(string(n), 10); !ok {
+				return false
+			}
+		case "array":
+			if _, ok := v.([]interface{}); !ok {
+				return false
+			}
+		case "object":
+			if _, ok := v.(map[string]interface{}); !ok {
+				return false
+			}
+		}
+	}
+
+	if n, ok := v.(json.Number); ok {
+		value, _ := new(big.Int).SetString(string(n), 10)
+		if min, ok := schema["minimum"].(json.Number); ok {
+			bound, _ := new(big.Int).SetString(string(min), 10)
+			if value == nil || value.Cmp(bound) < 0 {
+				return false
+			}
+		}
+		if max, ok := schema["maximum"].(json.Number); ok {
+			bound, _ := new(big.Int).SetString(string(max), 10)
+			if value == nil || value.Cmp(bound) > 0 {
+				return false
+			}
+		}
+	}
+
+	if pattern, ok := schema["pattern"].(string); ok {
+		if s, ok := v.(string); ok && !regexp.MustCompile(pattern).MatchString(s) {
+			return false
+		}
+	}
+
+	if c, ok := schema["const"]; ok && c != v {
+		return false
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			if e == v {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		for _, item := range v.([]interface{}) {
+			if !validateJSONSchema(items, defs, item) {
+				return false
+			}
+		}
+	}
+
+	if obj, ok := v.(map[string]interface{}); ok {
+		props, _ := schema["properties"].(map[string]interface{})
+		for key, value := range obj {
+			prop, ok := props[key].(map[string]interface{})
+			if !ok {
+				if schema["additionalProperties"] == false {
+					return false
+				}
+				continue
+			}
+			if !validateJSONSchema(prop, defs, value) {
+				return false
+			}
+		}
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, key := range required {
+				if _, ok := obj[key.(string)]; !ok {
+					return false
+				}
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		matched := false
+		for _, s := range anyOf {
+			if validateJSONSchema(s.(map[string]interface{}), defs, v) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok {
+		matched := 0
+		for _, s := range oneOf {
+			if validateJSONSchema(s.(map[string]interface{}), defs, v) {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func decodeJSONNumbers(t *testing.T, data []byte) interface{} {
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(string(data)))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func checkJSONSchema(t *testing.T, name string, v interface{}) {
+	doc, err := koinos.JSONSchema(name)
+	if err != nil {
+		t.Errorf("Could not get JSON Schema for %s: %s", name, err)
+		return
+	}
+
+	schema := decodeJSONNumbers(t, doc).(map[string]interface{})
+	if schema["$schema"] != "https://json-schema.org/draft/2020-12/schema" {
+		t.Errorf("Unexpected $schema for %s", name)
+	}
+	defs := schema["$defs"].(map[string]interface{})
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Errorf("Could not marshal %s: %s", name, err)
+		return
+	}
+
+	if !validateJSONSchema(schema, defs, decodeJSONNumbers(t, data)) {
+		t.Errorf("JSON for %s does not match its schema: %s", name, string(data))
+	}
+}
+
+func TestJSONSchemaRegistry(t *testing.T) {
+	names := make(map[string]bool)
+	for _, name := range koinos.JSONSchemaNames() {
+		names[name] = true
+	}
+
+	for _, name := range koinos.Registry.Names() {
+		if !names[name] {
+			t.Errorf("%s does not have a JSON Schema", name)
+			continue
+		}
+
+		v, err := koinos.Registry.New(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		checkJSONSchema(t, name, v)
+	}
+}
+
+func TestJSONSchemaValues(t *testing.T) {
+	op := koinos.NewCallContractOperation()
+	op.Args = koinos.VariableBlob{0x01, 0x02, 0x03}
+
+	active := koinos.NewActiveTransactionData()
+	limit, _ := koinos.NewUInt128FromHex("0x1" + strings.Repeat("0", 25))
+	active.ResourceLimit = *limit
+	active.Operations = append(active.Operations, koinos.Operation{Value: op})
+
+	trx := koinos.NewTransaction()
+	trx.ActiveData = *koinos.NewOpaqueActiveTransactionDataFromNative(*active)
+	checkJSONSchema(t, "koinos::protocol::transaction", trx)
+
+	block := koinos.NewBlock()
+	block.ActiveData = *koinos.NewOpaqueActiveBlockDataFromBlob(&koinos.VariableBlob{0xFF})
+	checkJSONSchema(t, "koinos::protocol::block", block)
+
+	u, _ := koinos.NewUInt256FromHex("0x8" + strings.Repeat("0", 63))
+	checkJSONSchema(t, "koinos::uint256", u)
+
+	doc, err := koinos.JSONSchema("koinos::uint8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := decodeJSONNumbers(t, doc).(map[string]interface{})
+	if validateJSONSchema(schema, nil, decodeJSONNumbers(t, []byte("256"))) {
+		t.Errorf("256 matched the koinos::uint8 schema")
+	}
+
+	if _, err = koinos.JSONSchema("koinos::foobar"); err == nil {
+		t.Errorf("err == nil")
+	}
+}
@@ -0,0 +1,140 @@
+package koinos_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestMultibaseVectors(t *testing.T) {
+	// Test vectors from the multibase specification for "yes mani !"
+	data := []byte("yes mani !")
+	vectors := map[koinos.Multibase]string{
+		koinos.MultibaseBase2:             "001111001011001010111001100100000011011010110000101101110011010010010000000100001",
+		koinos.MultibaseBase8:             "7362625631006654133464440102",
+		koinos.MultibaseBase10:            "9573277761329450583662625",
+		koinos.MultibaseBase16:            "f796573206d616e692021",
+		koinos.MultibaseBase16Upper:       "F796573206D616E692021",
+		koinos.MultibaseBase32:            "bpfsxgidnmfxgsibb",
+		koinos.MultibaseBase32Upper:       "BPFSXGIDNMFXGSIBB",
+		koinos.MultibaseBase32Pad:         "cpfsxgidnmfxgsibb",
+		koinos.MultibaseBase32PadUpper:    "CPFSXGIDNMFXGSIBB",
+		koinos.MultibaseBase32Hex:         "vf5in683dc5n6i811",
+		koinos.MultibaseBase32HexUpper:    "VF5IN683DC5N6I811",
+		koinos.MultibaseBase32HexPad:      "tf5in683dc5n6i811",
+		koinos.MultibaseBase32HexPadUpper: "TF5IN683DC5N6I811",
+		koinos.MultibaseBase32Z:           "hxf1zgedpcfzg1ebb",
+		koinos.MultibaseBase36:            "k2lcpzo5yikidynfl",
+		koinos.MultibaseBase36Upper:       "K2LCPZO5YIKIDYNFL",
+		koinos.MultibaseBase58BTC:         "z7paNL19xttacUY",
+		koinos.MultibaseBase58Flickr:      "Z7Pznk19XTTzBtx",
+		koinos.MultibaseBase64:            "meWVzIG1hbmkgIQ",
+		koinos.MultibaseBase64Pad:         "MeWVzIG1hbmkgIQ==",
+		koinos.MultibaseBase64URL:         "ueWVzIG1hbmkgIQ",
+		koinos.MultibaseBase64URLPad:      "UeWVzIG1hbmkgIQ==",
+	}
+
+	for m, expected := range vectors {
+		s, err := koinos.EncodeMultibase(data, m)
+		if err != nil || s != expected {
+			t.Errorf("Encoding with %c gave %s, expected %s", m, s, expected)
+		}
+
+		b, err := koinos.DecodeBytes(expected)
+		if err != nil || !bytes.Equal(b, data) {
+			t.Errorf("Could not decode %s", expected)
+		}
+	}
+
+	if _, err := koinos.EncodeMultibase(data, koinos.Multibase('q')); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestMultibaseLeadingZeros(t *testing.T) {
+	// Test vectors from the multibase specification for "\x00yes mani !"
+	data := []byte("\x00yes mani !")
+	vectors := []string{
+		"00000000001111001011001010111001100100000011011010110000101101110011010010010000000100001",
+		"7000745453462015530267151100204",
+		"90573277761329450583662625",
+		"f00796573206d616e692021",
+		"k02lcpzo5yikidynfl",
+		"z17paNL19xttacUY",
+		"Z17Pznk19XTTzBtx",
+	}
+
+	for _, expected := range vectors {
+		s, err := koinos.EncodeMultibase(data, koinos.Multibase(expected[0]))
+		if err != nil || s != expected {
+			t.Errorf("Encoding with %c gave %s, expected %s", expected[0], s, expected)
+		}
+
+		b, err := koinos.DecodeBytes(expected)
+		if err != nil || !bytes.Equal(b, data) {
+			t.Errorf("Could not decode %s", expected)
+		}
+	}
+}
+
+func TestMultibaseErrors(t *testing.T) {
+	if b, err := koinos.DecodeBytes("z"); err != nil || len(b) != 0 {
+		t.Errorf("z did not decode to empty bytes")
+	}
+
+	invalid := []string{"", "x", "z0OIl", "fzz", "f123", "b1", "m*", "02", "0101", "78", "71", "9a", "k-", "Z0", "hl"}
+	for _, s := range invalid {
+		if _, err := koinos.DecodeBytes(s); err == nil {
+			t.Errorf("%q was decoded", s)
+		}
+	}
+
+	var vb koinos.VariableBlob
+	if err := json.Unmarshal([]byte(`"zI"`), &vb); err == nil {
+		t.Errorf("Invalid base58 was unmarshaled")
+	}
+	if err := json.Unmarshal([]byte(`"q"`), &vb); err == nil {
+		t.Errorf("A single unknown character was unmarshaled")
+	}
+}
+
+func TestJSONMultibase(t *testing.T) {
+	defer koinos.SetJSONMultibase(koinos.MultibaseBase58BTC)
+
+	if err := koinos.SetJSONMultibase(koinos.Multibase('q')); err == nil {
+		t.Errorf("err == nil")
+	}
+	if koinos.JSONMultibase() != koinos.MultibaseBase58BTC {
+		t.Errorf("Unexpected default JSON encoding")
+	}
+
+	if err := koinos.SetJSONMultibase(koinos.MultibaseBase16); err != nil {
+		t.Fatal(err)
+	}
+
+	vb := koinos.VariableBlob{0x01, 0x02}
+	data, _ := json.Marshal(vb)
+	if string(data) != `"f0102"` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	mh := koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB}}
+	data, _ = json.Marshal(mh)
+	if string(data) != `"f1201ab"` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	var fb koinos.FixedBlob20
+	text, _ := fb.MarshalText()
+	if string(text) != "f"+string(bytes.Repeat([]byte("00"), 20)) {
+		t.Errorf("Unexpected text %s", text)
+	}
+
+	// Decoding accepts any encoding
+	var decoded koinos.VariableBlob
+	if err := json.Unmarshal([]byte(`"mAQI"`), &decoded); err != nil || !bytes.Equal(decoded, vb) {
+		t.Errorf("Could not decode base64 JSON")
+	}
+}
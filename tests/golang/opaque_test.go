@@ -0,0 +1,214 @@
+package koinos_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+// nonCanonicalActiveData encodes an empty active transaction data with an overlong operations length
+func nonCanonicalActiveData() *koinos.VariableBlob {
+	vb := koinos.NewActiveTransactionData().Serialize(koinos.NewVariableBlob())
+	*vb = append((*vb)[:len(*vb)-1], 0x80, 0x00)
+	return vb
+}
+
+func TestOpaqueByteExact(t *testing.T) {
+	blob := nonCanonicalActiveData()
+	o := koinos.NewOpaqueActiveTransactionDataFromBlob(blob)
+
+	// Decoders reject overlong varints, the data stays boxed
+	if err := o.Unbox(); err == nil {
+		t.Errorf("Unboxed an overlong varint")
+	}
+	if !o.IsBoxed() {
+		t.Errorf("Invalid data was unboxed")
+	}
+
+	// The original bytes are kept
+	if !bytes.Equal(*o.GetBlob(), *blob) {
+		t.Errorf("GetBlob re-encoded the data, %x", *o.GetBlob())
+	}
+	expected := koinos.EncodeVarint(koinos.NewVariableBlob(), uint64(len(*blob)))
+	*expected = append(*expected, *blob...)
+	if !bytes.Equal(*koinos.SerializeToBlob(o), *expected) {
+		t.Errorf("Serialize re-encoded the data")
+	}
+	if o.SerializedSize() != len(*expected) {
+		t.Errorf("Unexpected serialized size %d", o.SerializedSize())
+	}
+}
+
+func TestOpaqueGetNativeModification(t *testing.T) {
+	o := koinos.NewOpaqueActiveTransactionDataFromNative(*koinos.NewActiveTransactionData())
+	native, err := o.GetNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := koinos.NewActiveTransactionData()
+	for _, nonce := range []koinos.UInt64{1, 2} {
+		native.Nonce = nonce
+		expected.Nonce = nonce
+		vb := koinos.SerializeToBlob(o)
+		if !bytes.Equal((*vb)[1:], *koinos.SerializeToBlob(expected)) {
+			t.Errorf("Modification %d was not serialized, %x", nonce, *vb)
+		}
+	}
+
+	// Box stops serializing modifications
+	o.Box()
+	native.Nonce = 3
+	if !bytes.Equal(*o.GetBlob(), *koinos.SerializeToBlob(expected)) {
+		t.Errorf("Modification after Box was serialized")
+	}
+
+	// Unboxing a deserialized value and modifying it re-encodes it
+	_, d, err := koinos.DeserializeOpaqueActiveTransactionData(koinos.SerializeToBlob(o))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Unbox(); err != nil {
+		t.Fatal(err)
+	}
+	if native, err = d.GetNative(); err != nil {
+		t.Fatal(err)
+	}
+	native.Nonce = 4
+	expected.Nonce = 4
+	if !bytes.Equal(*d.GetBlob(), *koinos.SerializeToBlob(expected)) {
+		t.Errorf("Modification of a deserialized value was not serialized")
+	}
+}
+
+func TestOpaqueUnboxError(t *testing.T) {
+	o := koinos.NewOpaqueActiveTransactionDataFromBlob(&koinos.VariableBlob{0x01})
+	if err := o.Unbox(); err == nil {
+		t.Errorf("err == nil")
+	}
+	if !o.IsBoxed() {
+		t.Errorf("Invalid data was unboxed")
+	}
+	if _, err := o.MutableNative(); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	blob := koinos.NewActiveTransactionData().Serialize(koinos.NewVariableBlob())
+	*blob = append(*blob, 0x00)
+	o = koinos.NewOpaqueActiveTransactionDataFromBlob(blob)
+	if err := o.Unbox(); err != koinos.ErrTrailingBytes {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	// Invalid data is still serialized and marshaled as is
+	if !bytes.Equal(*o.GetBlob(), *blob) {
+		t.Errorf("GetBlob changed invalid data")
+	}
+	if _, err := json.Marshal(o); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOpaqueMutableNative(t *testing.T) {
+	original := koinos.NewActiveTransactionData()
+	original.Nonce = 7
+	blob := koinos.SerializeToBlob(original)
+	o := koinos.NewOpaqueActiveTransactionDataFromBlob(blob)
+	if err := o.Unbox(); err != nil {
+		t.Fatal(err)
+	}
+	c := *o
+
+	native, err := o.MutableNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+	native.Nonce = 42
+
+	expected := koinos.NewActiveTransactionData()
+	expected.Nonce = 42
+	if !bytes.Equal(*o.GetBlob(), *koinos.SerializeToBlob(expected)) {
+		t.Errorf("Modification was not serialized")
+	}
+
+	// The copy keeps the original bytes and value
+	if !bytes.Equal(*c.GetBlob(), *blob) {
+		t.Errorf("Copy was modified")
+	}
+	o.SetNative(*koinos.NewActiveTransactionData())
+	if o.IsBoxed() || !bytes.Equal(*c.GetBlob(), *blob) {
+		t.Errorf("SetNative did not replace the value")
+	}
+	if err = c.Unbox(); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := c.GetNative(); n.Nonce != 7 {
+		t.Errorf("Copy native was modified")
+	}
+}
+
+func TestOpaqueConcurrentReaders(t *testing.T) {
+	block := zeroCopyTestBlock()
+	expected := *koinos.SerializeToBlob(zeroCopyTestBlock())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !bytes.Equal(*koinos.SerializeToBlob(block), expected) {
+				t.Errorf("Unexpected concurrent serialization")
+			}
+			if _, err := json.Marshal(block); err != nil {
+				t.Error(err)
+			}
+			for j := range block.Transactions {
+				block.Transactions[j].ActiveData.GetBlob()
+				block.Transactions[j].ActiveData.SerializedSize()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestOpaqueZeroValueConcurrentReaders(t *testing.T) {
+	var trx koinos.Transaction
+	expected := *koinos.SerializeToBlob(koinos.NewActiveTransactionData())
+
+	var wg sync.WaitGroup
+	natives := make([]*koinos.ActiveTransactionData, 8)
+	for i := range natives {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if !bytes.Equal(*trx.ActiveData.GetBlob(), expected) {
+				t.Errorf("Unexpected serialization of a zero opaque")
+			}
+			trx.ActiveData.SerializedSize()
+			if native, err := trx.ActiveData.GetNative(); err == nil {
+				natives[i] = native
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Readers before the first GetBlob got the same decoded form, which the value serializes
+	var shared *koinos.ActiveTransactionData
+	for _, native := range natives {
+		if shared == nil {
+			shared = native
+		}
+		if native != nil && native != shared {
+			t.Fatalf("Readers got different decoded forms")
+		}
+	}
+	if shared != nil {
+		shared.Nonce = 1
+		if bytes.Equal(*trx.ActiveData.GetBlob(), expected) {
+			t.Errorf("Modification of the shared decoded form was not serialized")
+		}
+	}
+}
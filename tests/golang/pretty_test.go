@@ -0,0 +1,125 @@
+package koinos_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestStringFormat(t *testing.T) {
+	header := koinos.NewBlockHeader()
+	header.Height = 7
+	expected := "BlockHeader{Previous: {ID: 0, Digest: 0x (0 bytes)}, Height: 7, Timestamp: 0}"
+	if header.String() != expected {
+		t.Errorf("Unexpected String %s", header.String())
+	}
+	if s := fmt.Sprintf("%v", *header); s != expected {
+		t.Errorf("Unexpected %%v %s", s)
+	}
+	if s := fmt.Sprintf("%s", header); s != expected {
+		t.Errorf("Unexpected %%s %s", s)
+	}
+
+	if s := fmt.Sprint(koinos.SystemCallIDApplyBlock); s != "SystemCallIDApplyBlock" {
+		t.Errorf("Unexpected enum name %s", s)
+	}
+	if s := fmt.Sprintf("%d", koinos.SystemCallIDApplyBlock); s != "2494255093" {
+		t.Errorf("Unexpected enum value %s", s)
+	}
+	if s := koinos.ThunkID(5).String(); s != "ThunkID(5)" {
+		t.Errorf("Unexpected invalid enum %s", s)
+	}
+
+	var fb koinos.FixedBlob20
+	fb[0] = 0xAB
+	if s := fmt.Sprintf("%x", fb); s != "ab"+strings.Repeat("00", 19) {
+		t.Errorf("Unexpected %%x %s", s)
+	}
+	if s := fmt.Sprintf("%q", koinos.SystemCallIDApplyBlock); s != `"SystemCallIDApplyBlock"` {
+		t.Errorf("Unexpected %%q %s", s)
+	}
+}
+
+func TestBaseTypeStringFormat(t *testing.T) {
+	tests := []struct {
+		format   string
+		value    interface{}
+		expected string
+	}{
+		{"%v", koinos.String("koinos"), `"koinos"`},
+		{"%s", koinos.Boolean(true), "true"},
+		{"%t", koinos.Boolean(true), "true"},
+		{"%v", koinos.Int8(-8), "-8"},
+		{"%03d", koinos.UInt8(8), "008"},
+		{"%v", koinos.Int16(-16), "-16"},
+		{"%x", koinos.UInt16(16), "10"},
+		{"%v", koinos.Int32(-32), "-32"},
+		{"%v", koinos.UInt32(32), "32"},
+		{"%v", koinos.Int64(-64), "-64"},
+		{"%v", koinos.UInt64(64), "64"},
+		{"%v", koinos.VariableBlob{0x01, 0xAB}, "0x01ab (2 bytes)"},
+		{"%x", koinos.VariableBlob{0x01, 0xAB}, "01ab"},
+		{"%v", koinos.TimestampType(1000), "1000"},
+		{"%v", koinos.BlockHeightType(7), "7"},
+		{"%v", koinos.Multihash{ID: 1, Digest: koinos.VariableBlob{0x02}}, "Multihash{ID: 1, Digest: 0x02 (1 byte)}"},
+		{"%q", koinos.UInt64(64), `"64"`},
+	}
+
+	for _, test := range tests {
+		if s := fmt.Sprintf(test.format, test.value); s != test.expected {
+			t.Errorf("Unexpected %s of %T: %s, expected %s", test.format, test.value, s, test.expected)
+		}
+		if stringer, ok := test.value.(fmt.Stringer); !ok {
+			t.Errorf("%T does not implement fmt.Stringer", test.value)
+		} else if test.format == "%v" && stringer.String() != test.expected {
+			t.Errorf("Unexpected String of %T: %s", test.value, stringer.String())
+		}
+	}
+}
+
+func TestPretty(t *testing.T) {
+	op := koinos.NewCallContractOperation()
+	op.Args = koinos.VariableBlob{0x01}
+
+	active := koinos.NewActiveTransactionData()
+	active.Nonce = 3
+	active.Operations = append(active.Operations, koinos.Operation{Value: op}, koinos.Operation{Value: koinos.NewNopOperation()})
+
+	trx := koinos.NewTransaction()
+	trx.ActiveData = *koinos.NewOpaqueActiveTransactionDataFromNative(*active)
+	trx.PassiveData = *koinos.NewOpaquePassiveTransactionDataFromBlob(&koinos.VariableBlob{0xFF, 0xFF})
+
+	s := koinos.Pretty(trx)
+	for _, line := range []string{
+		"Transaction{",
+		"\n  ActiveData: {\n",
+		"\n    Nonce: 3,\n",
+		"\n      CallContractOperation{\n",
+		"\n        Args: 0x01 (1 byte),\n",
+		"\n      NopOperation{\n        Extensions: {},\n      },\n",
+		"\n  PassiveData: <opaque 0xffff (2 bytes)>,\n",
+	} {
+		if !strings.Contains(s, line) {
+			t.Errorf("Pretty output does not contain %q:\n%s", line, s)
+		}
+	}
+	if s != fmt.Sprintf("%+v", trx) {
+		t.Errorf("%%+v does not match Pretty")
+	}
+
+	// Rendering unboxes a copy of the opaque value
+	boxed := koinos.NewOpaqueActiveTransactionDataFromBlob(trx.ActiveData.GetBlob())
+	if !strings.Contains(koinos.Pretty(boxed), "Nonce: 3") || !boxed.IsBoxed() {
+		t.Errorf("Opaque contents were not rendered without unboxing")
+	}
+
+	s = koinos.PrettyWithOptions(trx.SignatureData, koinos.PrettyOptions{BlobEncoding: koinos.MultibaseBase58BTC})
+	if s != "z (0 bytes)" {
+		t.Errorf("Unexpected blob %s", s)
+	}
+	if koinos.Pretty(5) != "5" {
+		t.Errorf("Unexpected fallback")
+	}
+}
@@ -0,0 +1,119 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestRegistryRoundTrip(t *testing.T) {
+	names := koinos.Registry.Names()
+	if len(names) == 0 {
+		t.Errorf("Registry is empty")
+	}
+
+	for _, name := range names {
+		info, ok := koinos.Registry.Lookup(name)
+		if !ok {
+			t.Errorf("Registered name %s was not found", name)
+			continue
+		}
+		if info.Name != name {
+			t.Errorf("Registered name %s does not match TypeInfo name %s", name, info.Name)
+		}
+
+		v, err := koinos.Registry.New(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		vb := koinos.NewVariableBlob()
+		vb = v.Serialize(vb)
+
+		n, dv, err := koinos.Registry.Deserialize(name, vb)
+		if err != nil {
+			t.Errorf("Could not deserialize %s: %s", name, err)
+			continue
+		}
+		if n != uint64(len(*vb)) {
+			t.Errorf("Deserializing %s consumed %d of %d bytes", name, n, len(*vb))
+		}
+
+		rvb := koinos.NewVariableBlob()
+		rvb = dv.Serialize(rvb)
+		if !bytes.Equal(*vb, *rvb) {
+			t.Errorf("Serialization of %s did not round trip", name)
+		}
+	}
+}
+
+func TestRegistryMetadata(t *testing.T) {
+	info, ok := koinos.Registry.Lookup("koinos::protocol::call_contract_operation")
+	if !ok {
+		t.Fatalf("koinos::protocol::call_contract_operation is not registered")
+	}
+	if info.Kind != koinos.KindStruct || info.GoName != "CallContractOperation" {
+		t.Errorf("Unexpected type info for koinos::protocol::call_contract_operation")
+	}
+	for _, field := range info.Fields {
+		if _, ok := koinos.Registry.Lookup(field.TypeName); !ok {
+			t.Errorf("Field type %s is not registered", field.TypeName)
+		}
+	}
+
+	info, ok = koinos.Registry.Lookup("koinos::protocol::operation")
+	if !ok {
+		t.Fatalf("koinos::protocol::operation is not registered")
+	}
+	if info.Kind != koinos.KindVariant {
+		t.Errorf("koinos::protocol::operation is not a variant")
+	}
+
+	op := koinos.NewOperation()
+	for i, alt := range info.Alternatives {
+		v, err := koinos.Registry.New(alt)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		op.Value = v
+
+		vb := koinos.NewVariableBlob()
+		vb = op.Serialize(vb)
+		if (*vb)[0] != byte(i) {
+			t.Errorf("Alternative %s does not have tag %d", alt, i)
+		}
+		if op.TypeToName() != alt {
+			t.Errorf("TypeToName %s does not match registry name %s", op.TypeToName(), alt)
+		}
+	}
+}
+
+func TestRegistryErrors(t *testing.T) {
+	if _, err := koinos.Registry.New("koinos::foobar"); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	vb := koinos.VariableBlob{0x01}
+	if _, _, err := koinos.Registry.Deserialize("koinos::foobar", &vb); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	vb = koinos.VariableBlob{0x01, 0x02}
+	if _, err := koinos.Registry.DeserializeExact("koinos::uint8", &vb); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	v, err := koinos.Registry.DecodeJSON("koinos::uint64", []byte("42"))
+	if err != nil {
+		t.Error(err)
+	} else if *v.(*koinos.UInt64) != 42 {
+		t.Errorf("Unexpected value decoded from JSON")
+	}
+
+	if _, err = koinos.Registry.DecodeJSON("koinos::uint64", []byte("\"foo\"")); err == nil {
+		t.Errorf("err == nil")
+	}
+}
@@ -0,0 +1,74 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestSerializeInPlace(t *testing.T) {
+	trx := zeroCopyTestBlock().Transactions[0]
+	expected := koinos.SerializeToBlob(&trx)
+
+	// The result is visible through the original pointer
+	vb := koinos.NewVariableBlob()
+	trx.Serialize(vb)
+	if !bytes.Equal(*vb, *expected) {
+		t.Errorf("Serialize did not update the blob in place")
+	}
+
+	n := koinos.UInt32(7)
+	if out := n.Serialize(vb); out != vb {
+		t.Errorf("Serialize returned a different blob")
+	}
+	if !bytes.Equal((*vb)[len(*expected):], []byte{0, 0, 0, 7}) {
+		t.Errorf("Serialize did not append to the existing contents")
+	}
+}
+
+func TestSerializeSharedBuffer(t *testing.T) {
+	a := koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0x01, 0x02}}
+	b := koinos.Multihash{ID: 0x13, Digest: koinos.VariableBlob{0x03, 0x04}}
+
+	// Spare capacity used to be shared between results
+	base := make(koinos.VariableBlob, 0, 64)
+	first := a.Serialize(&base)
+	second := b.Serialize(&base)
+	if first != second || !bytes.Equal(base, []byte{0x12, 0x02, 0x01, 0x02, 0x13, 0x02, 0x03, 0x04}) {
+		t.Errorf("Unexpected serialization %x", base)
+	}
+
+	// AppendBinary follows append semantics and leaves the original slice header untouched
+	prefix := make([]byte, 1, 64)
+	x := a.AppendBinary(prefix)
+	if len(prefix) != 1 || !bytes.Equal(x[1:], *koinos.SerializeToBlob(&a)) {
+		t.Errorf("Unexpected AppendBinary result %x", x)
+	}
+	if x = b.AppendBinary(nil); !bytes.Equal(x, *koinos.SerializeToBlob(&b)) {
+		t.Errorf("Unexpected AppendBinary result %x", x)
+	}
+
+	if vb := koinos.EncodeVarint(&base, 300); vb != &base || !bytes.Equal(base[8:], []byte{0xAC, 0x02}) {
+		t.Errorf("EncodeVarint did not append in place")
+	}
+}
+
+func TestSerializeBoxesOpaque(t *testing.T) {
+	block := zeroCopyTestBlock()
+	if block.Transactions[0].ActiveData.IsBoxed() {
+		t.Fatal("Transaction data is boxed before serialization")
+	}
+
+	vb := block.Serialize(koinos.NewVariableBlob())
+	for i := range block.Transactions {
+		if !block.Transactions[i].ActiveData.IsBoxed() {
+			t.Errorf("Transaction %d was not boxed by Serialize", i)
+		}
+	}
+
+	// Serializing the boxed value produces the same bytes
+	if !bytes.Equal(*vb, *koinos.SerializeToBlob(block)) {
+		t.Errorf("Serialization changed after boxing")
+	}
+}
@@ -0,0 +1,71 @@
+package koinos_test
+
+import (
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func checkSerializedSize(t *testing.T, name string, v koinos.Serializeable) {
+	vb := v.Serialize(koinos.NewVariableBlob())
+	if v.SerializedSize() != len(*vb) {
+		t.Errorf("SerializedSize of %s is %d, expected %d", name, v.SerializedSize(), len(*vb))
+	}
+
+	exact := koinos.SerializeToBlob(v)
+	if len(*exact) != len(*vb) || cap(*exact) != len(*exact) {
+		t.Errorf("SerializeToBlob of %s did not allocate the exact size", name)
+	}
+}
+
+func TestSerializedSizeRegistry(t *testing.T) {
+	for _, name := range koinos.Registry.Names() {
+		v, err := koinos.Registry.New(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		checkSerializedSize(t, name, v)
+	}
+}
+
+func TestSerializedSizeValues(t *testing.T) {
+	block := zeroCopyTestBlock()
+	checkSerializedSize(t, "koinos::protocol::block", block)
+
+	// Opaque values are sized without boxing
+	trx := block.Transactions[0]
+	trx.ActiveData.Unbox()
+	checkSerializedSize(t, "koinos::protocol::transaction", &trx)
+
+	s := koinos.String(string(make([]byte, 300)))
+	checkSerializedSize(t, "koinos::string", &s)
+
+	mh := koinos.Multihash{ID: 0x1000, Digest: make(koinos.VariableBlob, 200)}
+	checkSerializedSize(t, "koinos::multihash", &mh)
+
+	size := block.SerializedSize()
+	if _, err := koinos.SerializeWithLimit(block, size-1); err != koinos.ErrSerializedSizeLimit {
+		t.Errorf("Size limit was not enforced")
+	}
+	vb, err := koinos.SerializeWithLimit(block, size)
+	if err != nil || len(*vb) != size {
+		t.Errorf("Unexpected result at the size limit")
+	}
+}
+
+func BenchmarkSerializeTransaction(b *testing.B) {
+	b.ReportAllocs()
+	trx := zeroCopyTestBlock().Transactions[0]
+	for i := 0; i < b.N; i++ {
+		trx.Serialize(koinos.NewVariableBlob())
+	}
+}
+
+func BenchmarkSerializeTransactionToBlob(b *testing.B) {
+	b.ReportAllocs()
+	trx := zeroCopyTestBlock().Transactions[0]
+	for i := 0; i < b.N; i++ {
+		koinos.SerializeToBlob(&trx)
+	}
+}
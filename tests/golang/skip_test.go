@@ -0,0 +1,134 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestSkipRegistry(t *testing.T) {
+	for _, name := range koinos.Registry.Names() {
+		info, _ := koinos.Registry.Lookup(name)
+		vb := koinos.SerializeToBlob(info.New())
+
+		n, err := info.Skip(vb)
+		if err != nil {
+			t.Errorf("Could not skip %s: %s", name, err)
+			continue
+		}
+		if m, _, _ := info.Deserialize(vb); n != m {
+			t.Errorf("Skip length %d of %s does not match Deserialize length %d", n, name, m)
+		}
+		if len(*vb) > 0 {
+			truncated := (*vb)[:len(*vb)-1]
+			if _, err = info.Skip(&truncated); err == nil {
+				t.Errorf("Truncated %s was skipped", name)
+			}
+		}
+	}
+}
+
+func TestSkipValidates(t *testing.T) {
+	if _, err := koinos.SkipBoolean(&koinos.VariableBlob{0x02}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := koinos.SkipString(&koinos.VariableBlob{0x01, 0xFF}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := koinos.SkipOperation(&koinos.VariableBlob{0x7F}); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := koinos.SkipThunkID(&koinos.VariableBlob{0x00, 0x00, 0x00, 0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestSkipBlock(t *testing.T) {
+	vb := koinos.SerializeToBlob(zeroCopyTestBlock())
+
+	n, err := koinos.SkipBlock(vb)
+	if err != nil || n != uint64(len(*vb)) {
+		t.Fatalf("Unexpected skip length %d, %v", n, err)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		koinos.SkipBlock(vb)
+	})
+	if allocs != 0 {
+		t.Errorf("SkipBlock allocated %f times", allocs)
+	}
+}
+
+func TestOffsets(t *testing.T) {
+	block := zeroCopyTestBlock()
+	vb := koinos.SerializeToBlob(block)
+
+	ranges, err := koinos.Offsets("koinos::protocol::block", *vb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{"id", "header", "active_data", "passive_data", "signature_data", "transactions"}
+	if len(ranges) != len(names) {
+		t.Fatalf("Unexpected ranges %v", ranges)
+	}
+	offset := 0
+	for i, r := range ranges {
+		if r.Name != names[i] || r.Offset != offset {
+			t.Errorf("Unexpected range %v", r)
+		}
+		offset += r.Length
+	}
+	if offset != len(*vb) {
+		t.Errorf("Ranges cover %d of %d bytes", offset, len(*vb))
+	}
+
+	header := ranges[1]
+	if !bytes.Equal((*vb)[header.Offset:header.Offset+header.Length], *koinos.SerializeToBlob(&block.Header)) {
+		t.Errorf("Header range does not match the header")
+	}
+
+	if _, err = koinos.Offsets("koinos::uint32", *vb); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err = koinos.Offsets("koinos::protocol::block", (*vb)[:8]); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestLocate(t *testing.T) {
+	block := zeroCopyTestBlock()
+	vb := koinos.SerializeToBlob(block)
+
+	r, err := koinos.Locate("koinos::protocol::block", *vb, "transactions[3].active_data.nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Length != 8 || !bytes.Equal((*vb)[r.Offset:r.Offset+r.Length], []byte{0, 0, 0, 0, 0, 0, 0, 3}) {
+		t.Errorf("Unexpected range %v", r)
+	}
+
+	r, err = koinos.Locate("koinos::protocol::block", *vb, "transactions[7].active_data.operations[0].entry_point")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal((*vb)[r.Offset:r.Offset+r.Length], []byte{0, 0, 0, 7}) {
+		t.Errorf("Unexpected range %v", r)
+	}
+
+	// Paths match the annotated segments
+	segments, _ := koinos.AnnotateSegments("koinos::protocol::block", *vb)
+	for _, s := range segments {
+		if s.Path != "signature_data" {
+			continue
+		}
+		if r, err = koinos.Locate("koinos::protocol::block", *vb, s.Path); err != nil || r.Offset+r.Length != s.Offset+s.Length {
+			t.Errorf("Unexpected range %v for segment %v, %v", r, s, err)
+		}
+	}
+
+	for _, path := range []string{"foo", "transactions[8]", "transactions.id", "id.digest"} {
+		if _, err = koinos.Locate("koinos::protocol::block", *vb, path); err == nil {
+			t.Errorf("Located %s", path)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package koinos_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestSystemCallIDFromName(t *testing.T) {
+	if id := koinos.SystemCallIDFromName("prints"); id != uint32(koinos.SystemCallIDPrints) {
+		t.Errorf("Expected %#x, was %#x", uint32(koinos.SystemCallIDPrints), id)
+	}
+	if id := koinos.SystemCallIDFromName("apply_block"); id != uint32(koinos.SystemCallIDApplyBlock) {
+		t.Errorf("Expected %#x, was %#x", uint32(koinos.SystemCallIDApplyBlock), id)
+	}
+	if id := koinos.ThunkIDFromName("prints"); id != uint32(koinos.ThunkIDPrints) {
+		t.Errorf("Expected %#x, was %#x", uint32(koinos.ThunkIDPrints), id)
+	}
+	if id := koinos.ThunkIDFromName("verify_block_header"); id != uint32(koinos.ThunkIDVerifyBlockHeader) {
+		t.Errorf("Expected %#x, was %#x", uint32(koinos.ThunkIDVerifyBlockHeader), id)
+	}
+}
+
+func TestSystemCallIDNames(t *testing.T) {
+	if name, ok := koinos.SystemCallNameFromID(uint32(koinos.SystemCallIDDbGetObject)); !ok || name != "db_get_object" {
+		t.Errorf("Expected db_get_object, was %q", name)
+	}
+	if name, ok := koinos.ThunkNameFromID(uint32(koinos.ThunkIDApplyTransaction)); !ok || name != "apply_transaction" {
+		t.Errorf("Expected apply_transaction, was %q", name)
+	}
+
+	if _, ok := koinos.SystemCallNameFromID(koinos.SystemCallIDFromName("test_system_call")); ok {
+		t.Errorf("Unregistered system call has a name")
+	}
+	id := koinos.RegisterSystemCallName("test_system_call")
+	if id>>28 != koinos.SystemCallIDPrefix || koinos.IsValidSystemCallID(koinos.SystemCallID(id)) {
+		t.Errorf("Unexpected system call ID %#x", id)
+	}
+	if name, ok := koinos.SystemCallNameFromID(id); !ok || name != "test_system_call" {
+		t.Errorf("Expected test_system_call, was %q", name)
+	}
+
+	thunk := koinos.RegisterThunkName("test_thunk")
+	if thunk>>28 != koinos.ThunkIDPrefix {
+		t.Errorf("Unexpected thunk ID %#x", thunk)
+	}
+	if name, ok := koinos.ThunkNameFromID(thunk); !ok || name != "test_thunk" {
+		t.Errorf("Expected test_thunk, was %q", name)
+	}
+}
+
+func TestRegisteredSystemCallSerialization(t *testing.T) {
+	op := koinos.NewSetSystemCallOperation()
+	op.CallID = koinos.UInt32(koinos.RegisterSystemCallName("test_registered_call"))
+	thunk := koinos.ThunkIDPrints
+	op.Target = koinos.SystemCallTarget{Value: &thunk}
+
+	vb := koinos.SerializeToBlob(op)
+	_, out, err := koinos.DeserializeSetSystemCallOperation(vb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.CallID != op.CallID {
+		t.Errorf("Expected call ID %#x, was %#x", uint32(op.CallID), uint32(out.CallID))
+	}
+	if name, ok := koinos.SystemCallNameFromID(uint32(out.CallID)); !ok || name != "test_registered_call" {
+		t.Errorf("Expected test_registered_call, was %q", name)
+	}
+
+	j, err := json.Marshal(op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsonOut koinos.SetSystemCallOperation
+	if err := json.Unmarshal(j, &jsonOut); err != nil {
+		t.Fatal(err)
+	}
+	if jsonOut.CallID != op.CallID {
+		t.Errorf("Expected call ID %#x from JSON, was %#x", uint32(op.CallID), uint32(jsonOut.CallID))
+	}
+}
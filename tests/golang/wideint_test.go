@@ -0,0 +1,278 @@
+package koinos_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+	"unsafe"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func randomBigInt(r *rand.Rand, bits int, signed bool) *big.Int {
+	// Bias toward small and boundary values as well as full width values
+	n := r.Intn(bits + 1)
+	v := new(big.Int).Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(n)))
+	if signed {
+		max := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+		v.Sub(v, new(big.Int).Rsh(new(big.Int).Lsh(big.NewInt(1), uint(n)), 1))
+		if v.Cmp(max) >= 0 {
+			v.Sub(max, big.NewInt(1))
+		}
+	}
+	return v
+}
+
+func TestUInt256MatchesBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	for i := 0; i < 2000; i++ {
+		x, y := randomBigInt(r, 256, false), randomBigInt(r, 256, false)
+		a, err := koinos.NewUInt256FromBigInt(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := koinos.NewUInt256FromBigInt(y)
+
+		if a.String() != x.String() {
+			t.Fatalf("String %s != %s", a.String(), x.String())
+		}
+		if a.BigInt().Cmp(x) != 0 {
+			t.Fatalf("BigInt %s != %s", a.BigInt(), x)
+		}
+		if a.Cmp(b) != x.Cmp(y) {
+			t.Fatalf("Cmp(%s, %s) was incorrect", x, y)
+		}
+		if !bytes.Equal(*a.Serialize(koinos.NewVariableBlob()), *koinos.SerializeBigInt(x, 32, false)) {
+			t.Fatalf("Serialization of %s does not match", x)
+		}
+
+		check := func(op string, got *koinos.UInt256, err error, expected *big.Int) {
+			if expected.Sign() < 0 || expected.Cmp(max) > 0 {
+				if err != koinos.ErrOverflow {
+					t.Fatalf("%s %s %s did not overflow", x, op, y)
+				}
+				return
+			}
+			if err != nil || got.BigInt().Cmp(expected) != 0 {
+				t.Fatalf("%s %s %s was incorrect", x, op, y)
+			}
+		}
+
+		v, err := a.Add(b)
+		check("+", v, err, new(big.Int).Add(x, y))
+		v, err = a.Sub(b)
+		check("-", v, err, new(big.Int).Sub(x, y))
+		v, err = a.Mul(b)
+		check("*", v, err, new(big.Int).Mul(x, y))
+		if y.Sign() != 0 {
+			v, err = a.Div(b)
+			check("/", v, err, new(big.Int).Quo(x, y))
+		}
+	}
+}
+
+func TestInt160MatchesBigInt(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 159), big.NewInt(1))
+	min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 159))
+
+	for i := 0; i < 2000; i++ {
+		x, y := randomBigInt(r, 160, true), randomBigInt(r, 160, true)
+		a, err := koinos.NewInt160FromBigInt(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := koinos.NewInt160FromBigInt(y)
+
+		if a.String() != x.String() {
+			t.Fatalf("String %s != %s", a.String(), x.String())
+		}
+		if a.Cmp(b) != x.Cmp(y) {
+			t.Fatalf("Cmp(%s, %s) was incorrect", x, y)
+		}
+
+		vb := a.Serialize(koinos.NewVariableBlob())
+		if !bytes.Equal(*vb, *koinos.SerializeBigInt(x, 20, true)) {
+			t.Fatalf("Serialization of %s does not match", x)
+		}
+		_, c, err := koinos.DeserializeInt160(vb)
+		if err != nil || *c != *a {
+			t.Fatalf("Deserialization of %s does not match", x)
+		}
+
+		check := func(op string, got *koinos.Int160, err error, expected *big.Int) {
+			if expected.Cmp(min) < 0 || expected.Cmp(max) > 0 {
+				if err != koinos.ErrOverflow {
+					t.Fatalf("%s %s %s did not overflow", x, op, y)
+				}
+				return
+			}
+			if err != nil || got.BigInt().Cmp(expected) != 0 {
+				t.Fatalf("%s %s %s was incorrect", x, op, y)
+			}
+		}
+
+		v, err := a.Add(b)
+		check("+", v, err, new(big.Int).Add(x, y))
+		v, err = a.Sub(b)
+		check("-", v, err, new(big.Int).Sub(x, y))
+		v, err = a.Mul(b)
+		check("*", v, err, new(big.Int).Mul(x, y))
+		if y.Sign() != 0 {
+			v, err = a.Div(b)
+			check("/", v, err, new(big.Int).Quo(x, y))
+		}
+	}
+}
+
+func TestWideIntComparable(t *testing.T) {
+	a, _ := koinos.NewUInt256FromString("123456789012345678901234567890")
+	b, _ := koinos.NewUInt256FromHex("0x18ee90ff6c373e0ee4e3f0ad2")
+	if *a != *b {
+		t.Errorf("Equal values are not ==")
+	}
+
+	seen := map[koinos.UInt256]bool{*a: true}
+	if !seen[*b] {
+		t.Errorf("Equal values do not hash equally")
+	}
+
+	c, _ := koinos.NewInt160FromString("-1")
+	vb := c.Serialize(koinos.NewVariableBlob())
+	_, d, _ := koinos.DeserializeInt160(vb)
+	if *c != *d {
+		t.Errorf("Deserialized value is not == to the original")
+	}
+
+	if _, err := koinos.NewUInt128FromBigInt(new(big.Int).Lsh(big.NewInt(1), 128)); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+var benchmarkUInt256, _ = koinos.NewUInt256FromString("115792089237316195423570985008687907853269984665640564039457584007913129639935")
+
+func BenchmarkUInt256Serialize(b *testing.B) {
+	b.ReportAllocs()
+	vb := make(koinos.VariableBlob, 0, 32)
+	for i := 0; i < b.N; i++ {
+		vb = vb[:0]
+		benchmarkUInt256.Serialize(&vb)
+	}
+}
+
+func BenchmarkBigIntSerialize(b *testing.B) {
+	b.ReportAllocs()
+	v := benchmarkUInt256.BigInt()
+	vb := make(koinos.VariableBlob, 0, 32)
+	for i := 0; i < b.N; i++ {
+		s := koinos.SerializeBigInt(v, 32, false)
+		_ = append(vb, *s...)
+	}
+}
+
+func BenchmarkUInt256Deserialize(b *testing.B) {
+	b.ReportAllocs()
+	vb := benchmarkUInt256.Serialize(koinos.NewVariableBlob())
+	for i := 0; i < b.N; i++ {
+		koinos.DeserializeUInt256(vb)
+	}
+}
+
+func BenchmarkBigIntDeserialize(b *testing.B) {
+	b.ReportAllocs()
+	vb := benchmarkUInt256.Serialize(koinos.NewVariableBlob())
+	for i := 0; i < b.N; i++ {
+		koinos.DeserializeBigInt(vb, 32, false)
+	}
+}
+
+func BenchmarkUInt256Cmp(b *testing.B) {
+	b.ReportAllocs()
+	other := koinos.NewUInt256FromUint64(1)
+	for i := 0; i < b.N; i++ {
+		benchmarkUInt256.Cmp(other)
+	}
+}
+
+func BenchmarkBigIntCmp(b *testing.B) {
+	b.ReportAllocs()
+	v, other := benchmarkUInt256.BigInt(), big.NewInt(1)
+	for i := 0; i < b.N; i++ {
+		v.Cmp(other)
+	}
+}
+
+func BenchmarkUInt256String(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchmarkUInt256.String()
+	}
+}
+
+func BenchmarkBigIntString(b *testing.B) {
+	b.ReportAllocs()
+	v := benchmarkUInt256.BigInt()
+	for i := 0; i < b.N; i++ {
+		_ = v.String()
+	}
+}
+
+func TestWideIntJSONBounds(t *testing.T) {
+	var i128 koinos.Int128
+	if err := json.Unmarshal([]byte("\"170141183460469231731687303715884105727\""), &i128); err != nil {
+		t.Errorf("Int128 max was not accepted: %s", err)
+	}
+	if err := json.Unmarshal([]byte("\"170141183460469231731687303715884105728\""), &i128); err == nil {
+		t.Errorf("Int128 max + 1 was accepted")
+	}
+	if err := json.Unmarshal([]byte("170141183460469231731687303715884105727"), &i128); err == nil {
+		t.Errorf("A number outside of int64 was accepted as an Int128")
+	}
+	if err := json.Unmarshal([]byte("1.5"), &i128); err == nil {
+		t.Errorf("A fraction was accepted as an Int128")
+	}
+	if err := json.Unmarshal([]byte("1e3"), &i128); err == nil {
+		t.Errorf("An exponent was accepted as an Int128")
+	}
+
+	var u160 koinos.UInt160
+	if err := json.Unmarshal([]byte("-1"), &u160); err == nil {
+		t.Errorf("-1 was accepted as a UInt160")
+	}
+	if err := json.Unmarshal([]byte("\"1461501637330902918203684832716283019655932542976\""), &u160); err == nil {
+		t.Errorf("UInt160 max + 1 was accepted")
+	}
+
+	var u256 koinos.UInt256
+	if err := json.Unmarshal([]byte("[1]"), &u256); err == nil {
+		t.Errorf("An array was accepted as a UInt256")
+	}
+}
+
+func TestWideIntValidate(t *testing.T) {
+	v := koinos.Int160Max()
+	if err := v.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	// Corrupt the bits above 160, which no constructor can produce
+	limbs := (*[3]uint64)(unsafe.Pointer(&v))
+	limbs[2] ^= 1 << 63
+	if err := v.Validate(); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err := json.Marshal(&v); err == nil {
+		t.Errorf("An invalid Int160 was marshaled to JSON")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("An invalid Int160 was serialized")
+		}
+	}()
+	v.Serialize(koinos.NewVariableBlob())
+}
@@ -0,0 +1,118 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func TestUInt128Arithmetic(t *testing.T) {
+	a := koinos.NewUInt128FromUint64(10)
+	b := koinos.NewUInt128FromUint64(3)
+
+	if v, err := a.Add(b); err != nil || v.Hex() != "0xd" {
+		t.Errorf("Unexpected result of 10 + 3")
+	}
+	if v, err := a.Sub(b); err != nil || v.Hex() != "0x7" {
+		t.Errorf("Unexpected result of 10 - 3")
+	}
+	if v, err := a.Mul(b); err != nil || v.Hex() != "0x1e" {
+		t.Errorf("Unexpected result of 10 * 3")
+	}
+	if v, err := a.Div(b); err != nil || v.Hex() != "0x3" {
+		t.Errorf("Unexpected result of 10 / 3")
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(a) != 0 {
+		t.Errorf("Unexpected comparison")
+	}
+
+	if _, err := b.Sub(a); err != koinos.ErrOverflow {
+		t.Errorf("3 - 10 did not overflow")
+	}
+
+	max := koinos.UInt128Max()
+	if _, err := max.Add(koinos.NewUInt128FromUint64(1)); err != koinos.ErrOverflow {
+		t.Errorf("Max + 1 did not overflow")
+	}
+	if _, err := max.Mul(koinos.NewUInt128FromUint64(2)); err != koinos.ErrOverflow {
+		t.Errorf("Max * 2 did not overflow")
+	}
+	if _, err := a.Div(koinos.NewUInt128()); err != koinos.ErrDivideByZero {
+		t.Errorf("Division by zero did not fail")
+	}
+
+	if v, err := a.Uint64(); err != nil || v != 10 {
+		t.Errorf("Unexpected uint64 conversion")
+	}
+	if _, err := max.Uint64(); err != koinos.ErrOverflow {
+		t.Errorf("Max converted to uint64")
+	}
+}
+
+func TestInt128Arithmetic(t *testing.T) {
+	a, err := koinos.NewInt128FromHex("-0x7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := koinos.NewInt128FromUint64(2)
+
+	if v, err := a.Div(b); err != nil || v.Hex() != "-0x3" {
+		t.Errorf("Division did not truncate toward zero")
+	}
+	if _, err := a.Uint64(); err != koinos.ErrOverflow {
+		t.Errorf("Negative value converted to uint64")
+	}
+
+	min := koinos.Int128Min()
+	neg, _ := koinos.NewInt128FromHex("-1")
+	if _, err := min.Div(neg); err != koinos.ErrOverflow {
+		t.Errorf("Min / -1 did not overflow")
+	}
+	if _, err := min.Sub(koinos.NewInt128FromUint64(1)); err != koinos.ErrOverflow {
+		t.Errorf("Min - 1 did not overflow")
+	}
+
+	if !bytes.Equal(neg.Bytes(), bytes.Repeat([]byte{0xFF}, 16)) {
+		t.Errorf("Unexpected bytes for -1")
+	}
+	v, err := koinos.NewInt128FromBytes(a.Bytes())
+	if err != nil || v.Cmp(a) != 0 {
+		t.Errorf("Bytes did not round trip")
+	}
+	if _, err = koinos.NewInt128FromBytes([]byte{0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestWideIntHex(t *testing.T) {
+	v, err := koinos.NewUInt256FromHex("0xFF")
+	if err != nil || v.Hex() != "0xff" {
+		t.Errorf("Unexpected hex round trip")
+	}
+
+	if _, err = koinos.NewUInt256FromHex("-0x1"); err != koinos.ErrOverflow {
+		t.Errorf("Negative hex parsed as UInt256")
+	}
+	if _, err = koinos.NewUInt160FromHex("0x1" + string(bytes.Repeat([]byte{'0'}, 40))); err != koinos.ErrOverflow {
+		t.Errorf("2^160 parsed as UInt160")
+	}
+	if _, err = koinos.NewInt160FromHex("0xZZ"); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err = koinos.NewInt256FromHex(""); err == nil {
+		t.Errorf("err == nil")
+	}
+	if _, err = koinos.NewInt256FromHex("--1"); err == nil {
+		t.Errorf("err == nil")
+	}
+
+	u, err := koinos.NewUInt160FromBytes(bytes.Repeat([]byte{0xFF}, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	max := koinos.UInt160Max()
+	if u.Cmp(&max) != 0 {
+		t.Errorf("Unexpected value from bytes")
+	}
+}
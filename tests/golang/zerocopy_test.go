@@ -0,0 +1,137 @@
+package koinos_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/koinos/koinos-types-golang"
+)
+
+func zeroCopyTestBlock() *koinos.Block {
+	block := koinos.NewBlock()
+	block.ID = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB, 0xCD, 0xEF}}
+	block.SignatureData = koinos.VariableBlob{0x01, 0x02, 0x03, 0x04}
+
+	for i := 0; i < 8; i++ {
+		op := koinos.NewCallContractOperation()
+		op.EntryPoint = koinos.UInt32(i)
+		op.Args = bytes.Repeat([]byte{byte(i)}, 256)
+
+		active := koinos.NewActiveTransactionData()
+		active.Nonce = koinos.UInt64(i)
+		active.Operations = append(active.Operations, koinos.Operation{Value: op})
+
+		trx := koinos.NewTransaction()
+		trx.ID = koinos.Multihash{ID: 0x12, Digest: bytes.Repeat([]byte{0xAA}, 32)}
+		trx.ActiveData = *koinos.NewOpaqueActiveTransactionDataFromNative(*active)
+		trx.SignatureData = bytes.Repeat([]byte{0x55}, 65)
+		block.Transactions = append(block.Transactions, *trx)
+	}
+
+	return block
+}
+
+func TestZeroCopyMatchesCopy(t *testing.T) {
+	vb := zeroCopyTestBlock().Serialize(koinos.NewVariableBlob())
+
+	n, copied, err := koinos.DeserializeBlock(vb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, aliased, err := koinos.DeserializeBlockZeroCopy(vb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != m || n != uint64(len(*vb)) {
+		t.Errorf("Unexpected bytes consumed, %d and %d", n, m)
+	}
+	if !bytes.Equal(*copied.Serialize(koinos.NewVariableBlob()), *aliased.Serialize(koinos.NewVariableBlob())) {
+		t.Errorf("Zero copy decode does not match the copying decode")
+	}
+
+	trx := aliased.Transactions[3]
+	trx.ActiveData.Unbox()
+	active, err := trx.ActiveData.GetNative()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active.Nonce != 3 {
+		t.Errorf("Unexpected nonce %d", active.Nonce)
+	}
+
+	if _, _, err = koinos.DeserializeBlockZeroCopy(&koinos.VariableBlob{0x01}); err == nil {
+		t.Errorf("err == nil")
+	}
+}
+
+func TestZeroCopyAliasing(t *testing.T) {
+	trx := koinos.NewTransaction()
+	trx.ID = koinos.Multihash{ID: 0x12, Digest: koinos.VariableBlob{0xAB, 0xCD}}
+	trx.SignatureData = koinos.VariableBlob{0x01, 0x02}
+	vb := trx.Serialize(koinos.NewVariableBlob())
+
+	_, copied, _ := koinos.DeserializeTransaction(vb)
+	_, aliased, err := koinos.DeserializeTransactionZeroCopy(vb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	(*vb)[len(*vb)-1] = 0xFF
+	if aliased.SignatureData[1] != 0xFF {
+		t.Errorf("Zero copy value does not alias the input")
+	}
+	if copied.SignatureData[1] != 0x02 {
+		t.Errorf("Copied value aliases the input")
+	}
+
+	// Appending must not write into the rest of the input
+	if cap(aliased.ID.Digest) != len(aliased.ID.Digest) {
+		t.Errorf("Aliased capacity was not clipped")
+	}
+	before := append(koinos.VariableBlob{}, *vb...)
+	_ = append(aliased.ID.Digest, 0x00, 0x00, 0x00)
+	if !bytes.Equal(before, *vb) {
+		t.Errorf("Append overwrote the input")
+	}
+
+	owned := koinos.CopyVariableBlob(aliased.SignatureData)
+	(*vb)[len(*vb)-1] = 0x02
+	if owned[1] != 0xFF {
+		t.Errorf("CopyVariableBlob result aliases the input")
+	}
+}
+
+func TestZeroCopyString(t *testing.T) {
+	s := koinos.String("hello")
+	vb := s.Serialize(koinos.NewVariableBlob())
+
+	_, aliased, err := koinos.DeserializeStringZeroCopy(vb)
+	if err != nil || *aliased != "hello" {
+		t.Fatalf("Unexpected string %q", *aliased)
+	}
+	(*vb)[1] = 'j'
+	if *aliased != "jello" {
+		t.Errorf("Zero copy string does not alias the input")
+	}
+
+	if _, _, err = koinos.DeserializeStringZeroCopy(&koinos.VariableBlob{0x01, 0xFF}); err == nil {
+		t.Errorf("Invalid UTF-8 was accepted")
+	}
+}
+
+func BenchmarkDeserializeBlock(b *testing.B) {
+	b.ReportAllocs()
+	vb := zeroCopyTestBlock().Serialize(koinos.NewVariableBlob())
+	for i := 0; i < b.N; i++ {
+		koinos.DeserializeBlock(vb)
+	}
+}
+
+func BenchmarkDeserializeBlockZeroCopy(b *testing.B) {
+	b.ReportAllocs()
+	vb := zeroCopyTestBlock().Serialize(koinos.NewVariableBlob())
+	for i := 0; i < b.N; i++ {
+		koinos.DeserializeBlockZeroCopy(vb)
+	}
+}